@@ -0,0 +1,135 @@
+package datetime
+
+import (
+	"fmt"
+	"github.com/gouef/utils"
+	"sync"
+	"time"
+)
+
+// maxNonBusinessDays consecutive days which are not business days searched before ErrNoBusinessDay
+const maxNonBusinessDays = 366
+
+// BusinessCalendar decides which days are business days
+type BusinessCalendar interface {
+	IsBusinessDay(t time.Time) bool
+}
+
+// HolidayCalendar business calendar with weekend days and holidays
+type HolidayCalendar struct {
+	mu       sync.RWMutex
+	weekend  []time.Weekday
+	holidays map[time.Time]bool
+	rules    []func(year int) time.Time
+}
+
+// NewHolidayCalendar calendar with Saturday and Sunday weekend and holidays
+func NewHolidayCalendar(holidays ...time.Time) *HolidayCalendar {
+	c := &HolidayCalendar{
+		weekend:  []time.Weekday{time.Saturday, time.Sunday},
+		holidays: map[time.Time]bool{},
+	}
+
+	c.Add(holidays...)
+
+	return c
+}
+
+// WithWeekend replaces weekend days
+func (c *HolidayCalendar) WithWeekend(days ...time.Weekday) *HolidayCalendar {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.weekend = days
+
+	return c
+}
+
+// Add adds holidays
+func (c *HolidayCalendar) Add(holidays ...time.Time) *HolidayCalendar {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for _, holiday := range holidays {
+		c.holidays[dayOf(holiday)] = true
+	}
+
+	return c
+}
+
+// AddRule adds holidays computed for each year, e.g. GetGoodFriday
+func (c *HolidayCalendar) AddRule(rule func(year int) time.Time) *HolidayCalendar {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.rules = append(c.rules, rule)
+
+	return c
+}
+
+// IsWeekend returns true when t is weekend day
+func (c *HolidayCalendar) IsWeekend(t time.Time) bool {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return utils.InArray(t.Weekday(), c.weekend)
+}
+
+// IsHoliday returns true when t is holiday
+func (c *HolidayCalendar) IsHoliday(t time.Time) bool {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	day := dayOf(t)
+
+	if c.holidays[day] {
+		return true
+	}
+
+	for _, rule := range c.rules {
+		if dayOf(rule(day.Year())).Equal(day) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// IsBusinessDay returns true when t is neither weekend nor holiday
+func (c *HolidayCalendar) IsBusinessDay(t time.Time) bool {
+	return !c.IsWeekend(t) && !c.IsHoliday(t)
+}
+
+// WeekendCalendar business calendar where only Saturday and Sunday are days off
+func WeekendCalendar() BusinessCalendar {
+	return NewHolidayCalendar()
+}
+
+// AddBusinessDays adds n (can be negative) business days of calendar to t,
+// it returns ErrNoBusinessDay when there is no business day in a year of days
+func AddBusinessDays(t time.Time, n int, calendar BusinessCalendar) (time.Time, error) {
+	step, from := 1, t
+
+	if n < 0 {
+		step, n = -1, -n
+	}
+
+	for skipped := 0; n > 0; {
+		t = t.AddDate(0, 0, step)
+
+		if calendar.IsBusinessDay(t) {
+			n, skipped, from = n-1, 0, t
+			continue
+		}
+
+		if skipped++; skipped == maxNonBusinessDays {
+			return time.Time{}, fmt.Errorf("%w in %d days from %s", ErrNoBusinessDay, maxNonBusinessDays, from.Format(time.DateOnly))
+		}
+	}
+
+	return t, nil
+}
+
+func dayOf(t time.Time) time.Time {
+	return GetDate(t.Year(), int(t.Month()), t.Day())
+}
@@ -0,0 +1,126 @@
+package calendar
+
+import (
+	"github.com/gouef/datetime"
+	"github.com/gouef/datetime/date"
+	"math"
+	"slices"
+	"time"
+)
+
+// Holidays decides which days are holidays, e.g. *datetime.HolidayCalendar
+type Holidays interface {
+	IsHoliday(t time.Time) bool
+}
+
+// Weekend decides which days are weekend days, e.g. *datetime.HolidayCalendar or Weekdays
+type Weekend interface {
+	IsWeekend(t time.Time) bool
+}
+
+// Weekdays weekend of fixed days of week, e.g. Weekdays{time.Friday, time.Saturday}
+type Weekdays []time.Weekday
+
+// IsWeekend returns true when weekday of t is in w
+func (w Weekdays) IsWeekend(t time.Time) bool {
+	return slices.Contains(w, t.Weekday())
+}
+
+// Options options of New, zero value has weeks from Sunday like cal, Saturday and Sunday weekend and no holidays
+type Options struct {
+	WeekStart time.Weekday
+	// Weekend nil is Saturday and Sunday
+	Weekend  Weekend
+	Holidays Holidays
+	// FixedWeeks month has always 6 weeks, so that calendars of all months have the same height
+	FixedWeeks bool
+}
+
+// Month grid of weeks of month, the first and the last week contain days of neighbouring months
+type Month struct {
+	Year      int
+	Month     time.Month
+	WeekStart time.Weekday
+	Weeks     []Week
+}
+
+// Week row of Month
+type Week struct {
+	// Year and Number ISO 8601 week of Monday of the week
+	Year   int
+	Number int
+	Days   [7]Day
+}
+
+// Day cell of Week
+type Day struct {
+	Date date.Date
+	// Outside day of previous or next month
+	Outside bool
+	Weekend bool
+	Holiday bool
+	// Today day of date.Now
+	Today bool
+}
+
+// New returns grid of month in year
+func New(year int, month time.Month, options Options) (*Month, error) {
+	if err := datetime.CheckField("year", year, 1, math.MaxInt); err != nil {
+		return nil, err
+	}
+
+	if err := datetime.CheckField("month", int(month), 1, 12); err != nil {
+		return nil, err
+	}
+
+	weekend := options.Weekend
+
+	if weekend == nil {
+		weekend = Weekdays{time.Saturday, time.Sunday}
+	}
+
+	first := time.Date(year, month, 1, 0, 0, 0, 0, time.UTC)
+	leading := (int(first.Weekday()) - int(options.WeekStart) + 7) % 7
+	weeks := (leading + datetime.DaysInMonth(year, int(month)) + 6) / 7
+
+	if options.FixedWeeks {
+		weeks = 6
+	}
+
+	m := &Month{Year: year, Month: month, WeekStart: options.WeekStart, Weeks: make([]Week, weeks)}
+	today := date.Now().Time()
+	day := first.AddDate(0, 0, -leading)
+
+	for w := range m.Weeks {
+		week := &m.Weeks[w]
+
+		for i := range week.Days {
+			week.Days[i] = Day{
+				Date:    date.FromTime(day),
+				Outside: day.Month() != month,
+				Weekend: weekend.IsWeekend(day),
+				Holiday: options.Holidays != nil && options.Holidays.IsHoliday(day),
+				Today:   day.Equal(today),
+			}
+
+			if day.Weekday() == time.Monday {
+				week.Year, week.Number = day.ISOWeek()
+			}
+
+			day = day.AddDate(0, 0, 1)
+		}
+	}
+
+	return m, nil
+}
+
+// Weekdays returns days of week in order of columns
+func (m *Month) Weekdays() [7]time.Weekday {
+	var weekdays [7]time.Weekday
+
+	for i := range weekdays {
+		weekdays[i] = (m.WeekStart + time.Weekday(i)) % 7
+	}
+
+	return weekdays
+}
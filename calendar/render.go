@@ -0,0 +1,169 @@
+package calendar
+
+import (
+	"fmt"
+	"github.com/gouef/datetime/locale"
+	"html"
+	"strings"
+	"unicode/utf8"
+)
+
+// TextRenderer renders Month as plain text like cal, e.g.
+//
+//	    October 2026
+//	Su Mo Tu We Th Fr Sa
+//	             1  2  3
+//	 4  5  6  7  8  9 10
+type TextRenderer struct {
+	// Locale names of month and weekdays, nil is english
+	Locale *locale.Locale
+	// WeekNumbers adds column of ISO week numbers
+	WeekNumbers bool
+	// Outside shows days of neighbouring months
+	Outside bool
+}
+
+// HTMLRenderer renders Month as HTML table, cells have classes "outside", "weekend", "holiday" and "today"
+type HTMLRenderer struct {
+	// Locale names of month and weekdays, nil is english
+	Locale *locale.Locale
+	// WeekNumbers adds column of ISO week numbers
+	WeekNumbers bool
+	// Outside shows days of neighbouring months, their cells are empty otherwise
+	Outside bool
+	// Class class of table, "calendar" when empty
+	Class string
+}
+
+// String returns m rendered by TextRenderer without options
+func (m *Month) String() string {
+	return TextRenderer{}.Render(m)
+}
+
+// Render returns m as lines of text without trailing spaces
+func (r TextRenderer) Render(m *Month) string {
+	l := localeOf(r.Locale)
+	width, prefix := 20, ""
+
+	if r.WeekNumbers {
+		width, prefix = 23, "   "
+	}
+
+	title := m.title(l)
+	lines := []string{strings.Repeat(" ", max(0, (width-utf8.RuneCountInString(title))/2)) + title}
+	header := make([]string, 7)
+
+	for i, weekday := range m.Weekdays() {
+		name := []rune(l.WeekdayName(weekday, locale.ContextStandalone, locale.WidthAbbreviated))
+		header[i] = fmt.Sprintf("%-2s", string(name[:min(2, len(name))]))
+	}
+
+	lines = append(lines, prefix+strings.Join(header, " "))
+
+	for _, week := range m.Weeks {
+		cells := make([]string, 7)
+
+		for i, day := range week.Days {
+			cells[i] = "  "
+
+			if !day.Outside || r.Outside {
+				cells[i] = fmt.Sprintf("%2d", day.Date.Day())
+			}
+		}
+
+		line := strings.Join(cells, " ")
+
+		if r.WeekNumbers {
+			line = fmt.Sprintf("%2d ", week.Number) + line
+		}
+
+		lines = append(lines, strings.TrimRight(line, " "))
+	}
+
+	return strings.Join(lines, "\n") + "\n"
+}
+
+// Render returns m as HTML table, days are in time elements with datetime attribute
+func (r HTMLRenderer) Render(m *Month) string {
+	l := localeOf(r.Locale)
+	class := r.Class
+
+	if class == "" {
+		class = "calendar"
+	}
+
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "<table class=\"%s\">\n", html.EscapeString(class))
+	fmt.Fprintf(&b, "<caption>%s</caption>\n", html.EscapeString(m.title(l)))
+	b.WriteString("<thead>\n<tr>")
+
+	if r.WeekNumbers {
+		b.WriteString("<th class=\"week\"></th>")
+	}
+
+	for _, weekday := range m.Weekdays() {
+		fmt.Fprintf(&b, "<th scope=\"col\" abbr=\"%s\">%s</th>",
+			html.EscapeString(l.WeekdayName(weekday, locale.ContextStandalone, locale.WidthWide)),
+			html.EscapeString(l.WeekdayName(weekday, locale.ContextStandalone, locale.WidthAbbreviated)))
+	}
+
+	b.WriteString("</tr>\n</thead>\n<tbody>\n")
+
+	for _, week := range m.Weeks {
+		b.WriteString("<tr>")
+
+		if r.WeekNumbers {
+			fmt.Fprintf(&b, "<th scope=\"row\" class=\"week\">%d</th>", week.Number)
+		}
+
+		for _, day := range week.Days {
+			if day.Outside && !r.Outside {
+				b.WriteString("<td class=\"outside\"></td>")
+				continue
+			}
+
+			b.WriteString("<td")
+
+			if classes := day.classes(); len(classes) > 0 {
+				fmt.Fprintf(&b, " class=\"%s\"", strings.Join(classes, " "))
+			}
+
+			fmt.Fprintf(&b, "><time datetime=\"%s\">%d</time></td>", day.Date.ToString(), day.Date.Day())
+		}
+
+		b.WriteString("</tr>\n")
+	}
+
+	b.WriteString("</tbody>\n</table>\n")
+
+	return b.String()
+}
+
+func (d Day) classes() []string {
+	var classes []string
+
+	for _, class := range []struct {
+		name string
+		is   bool
+	}{{"outside", d.Outside}, {"weekend", d.Weekend}, {"holiday", d.Holiday}, {"today", d.Today}} {
+		if class.is {
+			classes = append(classes, class.name)
+		}
+	}
+
+	return classes
+}
+
+// title returns standalone name of month and year, e.g. "October 2026"
+func (m *Month) title(l *locale.Locale) string {
+	return fmt.Sprintf("%s %d", l.MonthName(m.Month, locale.ContextStandalone, locale.WidthWide), m.Year)
+}
+
+func localeOf(l *locale.Locale) *locale.Locale {
+	if l == nil {
+		return locale.Default()
+	}
+
+	return l
+}
@@ -0,0 +1,288 @@
+package datetime
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+)
+
+// Clock source of current time used by Now functions
+type Clock interface {
+	Now() time.Time
+	NewTimer(d time.Duration) Timer
+	NewTicker(d time.Duration) Ticker
+	After(d time.Duration) <-chan time.Time
+}
+
+// Timer single event of Clock, see time.Timer
+type Timer interface {
+	C() <-chan time.Time
+	Stop() bool
+	Reset(d time.Duration) bool
+}
+
+// Ticker repeated events of Clock, see time.Ticker
+type Ticker interface {
+	C() <-chan time.Time
+	Stop()
+	Reset(d time.Duration)
+}
+
+type clockKey struct{}
+
+var (
+	clockMu      sync.RWMutex
+	defaultClock Clock = SystemClock{}
+)
+
+// SetClock sets clock used by Now functions, nil restores SystemClock
+func SetClock(clock Clock) {
+	clockMu.Lock()
+	defer clockMu.Unlock()
+
+	if clock == nil {
+		clock = SystemClock{}
+	}
+
+	defaultClock = clock
+}
+
+// GetClock returns clock used by Now functions
+func GetClock() Clock {
+	clockMu.RLock()
+	defer clockMu.RUnlock()
+
+	return defaultClock
+}
+
+// WithClock returns context carrying clock
+func WithClock(ctx context.Context, clock Clock) context.Context {
+	return context.WithValue(ctx, clockKey{}, clock)
+}
+
+// ClockFromContext returns clock carried by ctx, or GetClock when there is none
+func ClockFromContext(ctx context.Context) Clock {
+	if clock, ok := ctx.Value(clockKey{}).(Clock); ok && clock != nil {
+		return clock
+	}
+
+	return GetClock()
+}
+
+// SystemClock clock of time package
+type SystemClock struct{}
+
+func (SystemClock) Now() time.Time {
+	return time.Now()
+}
+
+func (SystemClock) NewTimer(d time.Duration) Timer {
+	return systemTimer{time.NewTimer(d)}
+}
+
+func (SystemClock) NewTicker(d time.Duration) Ticker {
+	return systemTicker{time.NewTicker(d)}
+}
+
+func (SystemClock) After(d time.Duration) <-chan time.Time {
+	return time.After(d)
+}
+
+type systemTimer struct{ *time.Timer }
+
+func (t systemTimer) C() <-chan time.Time {
+	return t.Timer.C
+}
+
+type systemTicker struct{ *time.Ticker }
+
+func (t systemTicker) C() <-chan time.Time {
+	return t.Ticker.C
+}
+
+// FakeClock clock which moves only by Set and Advance, timers and tickers fire while it moves.
+// It is safe for concurrent use.
+type FakeClock struct {
+	mu      sync.Mutex
+	now     time.Time
+	waiters []*fakeWaiter
+}
+
+type fakeWaiter struct {
+	clock    *FakeClock
+	c        chan time.Time
+	deadline time.Time
+	period   time.Duration
+}
+
+// NewFakeClock fake clock set to now
+func NewFakeClock(now time.Time) *FakeClock {
+	return &FakeClock{now: now}
+}
+
+func (c *FakeClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.now
+}
+
+// Set moves clock to now, timers and tickers due until now fire when clock moves forward
+func (c *FakeClock) Set(now time.Time) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.fire(now)
+	c.now = now
+}
+
+// Advance moves clock forward by d
+func (c *FakeClock) Advance(d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	target := c.now.Add(d)
+	c.fire(target)
+	c.now = target
+}
+
+func (c *FakeClock) NewTimer(d time.Duration) Timer {
+	return fakeTimer{c.add(d, 0)}
+}
+
+func (c *FakeClock) NewTicker(d time.Duration) Ticker {
+	if d <= 0 {
+		panic("non-positive interval for FakeClock.NewTicker")
+	}
+
+	return fakeTicker{c.add(d, d)}
+}
+
+func (c *FakeClock) After(d time.Duration) <-chan time.Time {
+	return c.NewTimer(d).C()
+}
+
+// Waiters returns number of active timers and tickers
+func (c *FakeClock) Waiters() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return len(c.waiters)
+}
+
+func (c *FakeClock) add(d time.Duration, period time.Duration) *fakeWaiter {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	w := &fakeWaiter{clock: c, c: make(chan time.Time, 1), deadline: c.now.Add(d), period: period}
+
+	if d <= 0 && period == 0 {
+		w.send(c.now)
+		return w
+	}
+
+	c.waiters = append(c.waiters, w)
+
+	return w
+}
+
+// fire sends to waiters due until target in order of deadlines, clock is set to each deadline meanwhile
+func (c *FakeClock) fire(target time.Time) {
+	for {
+		sort.SliceStable(c.waiters, func(i, j int) bool {
+			return c.waiters[i].deadline.Before(c.waiters[j].deadline)
+		})
+
+		if len(c.waiters) == 0 || c.waiters[0].deadline.After(target) {
+			return
+		}
+
+		w := c.waiters[0]
+		c.now = w.deadline
+		w.send(c.now)
+
+		if w.period > 0 {
+			w.deadline = w.deadline.Add(w.period)
+		} else {
+			c.waiters = c.waiters[1:]
+		}
+	}
+}
+
+func (c *FakeClock) remove(w *fakeWaiter) bool {
+	for i, waiter := range c.waiters {
+		if waiter == w {
+			c.waiters = append(c.waiters[:i], c.waiters[i+1:]...)
+			return true
+		}
+	}
+
+	return false
+}
+
+// send does not block, like time.Ticker it drops value when receiver is behind
+func (w *fakeWaiter) send(t time.Time) {
+	select {
+	case w.c <- t:
+	default:
+	}
+}
+
+func (w *fakeWaiter) stop() bool {
+	w.clock.mu.Lock()
+	defer w.clock.mu.Unlock()
+
+	return w.clock.remove(w)
+}
+
+func (w *fakeWaiter) reset(d time.Duration) bool {
+	w.clock.mu.Lock()
+	defer w.clock.mu.Unlock()
+
+	active := w.clock.remove(w)
+	w.deadline = w.clock.now.Add(d)
+
+	if w.period > 0 {
+		w.period = d
+	} else if d <= 0 {
+		w.send(w.clock.now)
+		return active
+	}
+
+	w.clock.waiters = append(w.clock.waiters, w)
+
+	return active
+}
+
+type fakeTimer struct{ *fakeWaiter }
+
+func (t fakeTimer) C() <-chan time.Time {
+	return t.c
+}
+
+func (t fakeTimer) Stop() bool {
+	return t.stop()
+}
+
+func (t fakeTimer) Reset(d time.Duration) bool {
+	return t.reset(d)
+}
+
+type fakeTicker struct{ *fakeWaiter }
+
+func (t fakeTicker) C() <-chan time.Time {
+	return t.c
+}
+
+func (t fakeTicker) Stop() {
+	t.stop()
+}
+
+func (t fakeTicker) Reset(d time.Duration) {
+	if d <= 0 {
+		panic("non-positive interval for FakeClock ticker Reset")
+	}
+
+	t.reset(d)
+}
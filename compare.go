@@ -0,0 +1,113 @@
+package datetime
+
+import (
+	"fmt"
+	"time"
+)
+
+// Granularity the smallest unit compared by CompareAt
+type Granularity int
+
+const (
+	GranularityNanosecond Granularity = iota
+	GranularityMicrosecond
+	GranularityMillisecond
+	GranularitySecond
+	GranularityMinute
+	GranularityHour
+	GranularityDay
+	GranularityMonth
+	GranularityYear
+)
+
+var granularityNames = [...]string{"nanosecond", "microsecond", "millisecond", "second", "minute", "hour", "day", "month", "year"}
+
+var granularityUnits = [...]time.Duration{time.Nanosecond, time.Microsecond, time.Millisecond, time.Second, time.Minute, time.Hour}
+
+func (g Granularity) String() string {
+	if g < 0 || int(g) >= len(granularityNames) {
+		return fmt.Sprintf("Granularity(%d)", int(g))
+	}
+
+	return granularityNames[g]
+}
+
+// Components reports which parts a value has, values which do not implement it have both date and time
+type Components interface {
+	HasDate() bool
+	HasTime() bool
+}
+
+// CompareAt compares a with b up to granularity g, it returns -1, 0 or +1 like Compare.
+// Granularity of day, month or year needs date of both values, finer granularity needs time of both values
+// and either both or none of them must have date, e.g. date.Date and time.Time are not comparable at all.
+// Incomparable values return error matching ErrIncomparable
+func CompareAt(a, b Interface, g Granularity) (int, error) {
+	if g < GranularityNanosecond || g > GranularityYear {
+		return 0, fmt.Errorf("%w at unknown %s", ErrIncomparable, g)
+	}
+
+	aDate, aTime := components(a)
+	bDate, bTime := components(b)
+
+	if (g >= GranularityDay && (!aDate || !bDate)) || (g < GranularityDay && (!aTime || !bTime || aDate != bDate)) {
+		return 0, fmt.Errorf("%w: %s and %s at granularity %s", ErrIncomparable, kind(aDate, aTime), kind(bDate, bTime), g)
+	}
+
+	return truncate(a.Time(), g).Compare(truncate(b.Time(), g)), nil
+}
+
+// EqualAt reports whether a and b are equal up to granularity g, see CompareAt
+func EqualAt(a, b Interface, g Granularity) (bool, error) {
+	c, err := CompareAt(a, b, g)
+
+	return c == 0, err
+}
+
+func components(value Interface) (bool, bool) {
+	if c, ok := value.(Components); ok {
+		return c.HasDate(), c.HasTime()
+	}
+
+	return true, true
+}
+
+func kind(hasDate, hasTime bool) string {
+	switch {
+	case hasDate && hasTime:
+		return "date time"
+	case hasDate:
+		return "date"
+	}
+
+	return "time"
+}
+
+func truncate(t time.Time, g Granularity) time.Time {
+	t = t.UTC()
+
+	switch g {
+	case GranularityYear:
+		return StartOfYear(t)
+	case GranularityMonth:
+		return StartOfMonth(t)
+	case GranularityDay:
+		return StartOfDay(t)
+	}
+
+	// time.Truncate counts from zero time, which is midnight, so units up to hour are aligned to day
+	return t.Truncate(granularityUnits[g])
+}
+
+func (d DateTime) HasDate() bool {
+	return true
+}
+
+func (d DateTime) HasTime() bool {
+	return true
+}
+
+// CompareAt compares d with u up to granularity g, see CompareAt
+func (d DateTime) CompareAt(u Interface, g Granularity) (int, error) {
+	return CompareAt(d, u, g)
+}
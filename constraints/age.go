@@ -0,0 +1,33 @@
+package constraints
+
+import (
+	"github.com/gouef/datetime"
+	"github.com/gouef/datetime/date"
+)
+
+// MinAge value is birth date, age at At (nil is today) must be at least Years,
+// birthday of February 29 is resolved by LeapDay, by default it is reached on March 1 in common years
+type MinAge struct {
+	Years   int
+	At      datetime.Interface
+	LeapDay date.LeapDayPolicy
+}
+
+// Validate function for validate value
+//
+// Example:
+//
+//	errs := validator.Validate(birthday, constraints.MinAge{Years: 18})
+func (c MinAge) Validate(value any) error {
+	birth, err := toTime(value)
+
+	if err != nil {
+		return err
+	}
+
+	if date.AgeWithPolicy(date.FromTime(birth), date.FromTime(reference(c.At)), c.LeapDay) < c.Years {
+		return violation("this value must be at least %d years ago", c.Years)
+	}
+
+	return nil
+}
@@ -0,0 +1,95 @@
+package constraints
+
+import (
+	"github.com/gouef/datetime"
+	goTime "time"
+)
+
+// IsBusinessDay value must be business day of Calendar, nil Calendar is datetime.WeekendCalendar
+type IsBusinessDay struct {
+	Calendar datetime.BusinessCalendar
+}
+
+// Validate function for validate value
+//
+// Example:
+//
+//	con := constraints.IsBusinessDay{Calendar: datetime.NewHolidayCalendar(holidays...)}
+//	errs := validator.Validate(value, con)
+func (c IsBusinessDay) Validate(value any) error {
+	t, err := toTime(value)
+
+	if err != nil {
+		return err
+	}
+
+	if !calendar(c.Calendar).IsBusinessDay(t) {
+		return violation("this value must be business day")
+	}
+
+	return nil
+}
+
+// NotWeekend value must not be Saturday or Sunday
+type NotWeekend struct{}
+
+// Validate function for validate value
+//
+// Example:
+//
+//	errs := validator.Validate(value, constraints.NotWeekend{})
+func (c NotWeekend) Validate(value any) error {
+	t, err := toTime(value)
+
+	if err != nil {
+		return err
+	}
+
+	if t.Weekday() == goTime.Saturday || t.Weekday() == goTime.Sunday {
+		return violation("this value must not be weekend")
+	}
+
+	return nil
+}
+
+// MinBusinessDays value must be at least Days business days of Calendar after From, nil From is today
+type MinBusinessDays struct {
+	Days     int
+	From     datetime.Interface
+	Calendar datetime.BusinessCalendar
+}
+
+// Validate function for validate value
+//
+// Example:
+//
+//	// delivery date must be at least 2 business days from today
+//	con := constraints.MinBusinessDays{Days: 2}
+//	errs := validator.Validate(deliveryDate, con)
+func (c MinBusinessDays) Validate(value any) error {
+	t, err := toTime(value)
+
+	if err != nil {
+		return err
+	}
+
+	earliest, err := datetime.AddBusinessDays(day(reference(c.From)), c.Days, calendar(c.Calendar))
+
+	if err != nil {
+		return err
+	}
+
+	if day(t).Before(earliest) {
+		return violation("this value must be %s or later", earliest.Format(goTime.DateOnly))
+	}
+
+	return nil
+}
+
+func calendar(c datetime.BusinessCalendar) datetime.BusinessCalendar {
+	if c == nil {
+		return datetime.WeekendCalendar()
+	}
+
+	return c
+}
@@ -0,0 +1,99 @@
+package constraints
+
+import (
+	"github.com/gouef/datetime"
+	goTime "time"
+)
+
+// DateAfter value must be after Date, nil Date is now
+type DateAfter struct {
+	Date      datetime.Interface
+	Inclusive bool
+}
+
+// Validate function for validate value
+//
+// Example:
+//
+//	con := constraints.DateAfter{Date: date.Now(), Inclusive: true}
+//	errs := validator.Validate(value, con)
+func (c DateAfter) Validate(value any) error {
+	t, err := toTime(value)
+
+	if err != nil {
+		return err
+	}
+
+	ref := reference(c.Date)
+
+	if t.After(ref) || (c.Inclusive && t.Equal(ref)) {
+		return nil
+	}
+
+	if c.Inclusive {
+		return violation("this value must be %s or later", ref.Format(goTime.DateTime))
+	}
+
+	return violation("this value must be after %s", ref.Format(goTime.DateTime))
+}
+
+// DateBefore value must be before Date, nil Date is now
+type DateBefore struct {
+	Date      datetime.Interface
+	Inclusive bool
+}
+
+// Validate function for validate value
+//
+// Example:
+//
+//	con := constraints.DateBefore{Date: deadline}
+//	errs := validator.Validate(value, con)
+func (c DateBefore) Validate(value any) error {
+	t, err := toTime(value)
+
+	if err != nil {
+		return err
+	}
+
+	ref := reference(c.Date)
+
+	if t.Before(ref) || (c.Inclusive && t.Equal(ref)) {
+		return nil
+	}
+
+	if c.Inclusive {
+		return violation("this value must be %s or earlier", ref.Format(goTime.DateTime))
+	}
+
+	return violation("this value must be before %s", ref.Format(goTime.DateTime))
+}
+
+// DateInRange value must be in Range, e.g. *datetime.Range, *date.Range or *time.Range
+type DateInRange struct {
+	Range interface {
+		Is(value any) bool
+		String() string
+	}
+}
+
+// Validate function for validate value
+//
+// Example:
+//
+//	window, _ := date.RangeFromString("[2026-12-24, 2026-12-26]")
+//	con := constraints.DateInRange{Range: window}
+//	errs := validator.Validate(value, con)
+func (c DateInRange) Validate(value any) error {
+	t, err := toTime(value)
+
+	if err != nil {
+		return err
+	}
+
+	if !c.Range.Is(t) {
+		return violation("this value must be in range %s", c.Range.String())
+	}
+
+	return nil
+}
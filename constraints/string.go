@@ -0,0 +1,36 @@
+package constraints
+
+import (
+	"fmt"
+	"github.com/gouef/datetime"
+)
+
+// ValidDateTimeString value must be string of date time "2006-01-02 15:04:05",
+// or of Pattern when its Layout is not empty
+type ValidDateTimeString struct {
+	Pattern datetime.Pattern
+}
+
+// Validate function for validate value
+//
+// Example:
+//
+//	con := constraints.ValidDateTimeString{Pattern: datetime.ICU("dd.MM.yyyy HH:mm")}
+//	errs := validator.Validate(value, con)
+func (c ValidDateTimeString) Validate(value any) error {
+	s, ok := value.(string)
+
+	if !ok {
+		return fmt.Errorf("%w %T, this value must be string", datetime.ErrUnsupportedType, value)
+	}
+
+	var err error
+
+	if c.Pattern.Layout != "" {
+		_, err = datetime.ParseTime(c.Pattern, s)
+	} else {
+		_, err = datetime.ParseString(s)
+	}
+
+	return err
+}
@@ -0,0 +1,79 @@
+package constraints
+
+import (
+	"errors"
+	"fmt"
+	"github.com/gouef/datetime"
+	"github.com/gouef/datetime/date"
+	"github.com/gouef/datetime/time"
+	"reflect"
+	goTime "time"
+)
+
+// ErrViolation matches every error of failed constraint
+var ErrViolation = errors.New("constraint violation")
+
+type dateValue interface {
+	Date() datetime.Interface
+}
+
+// toTime converts value validated by constraints, it accepts datetime.Interface, DateTime, date.Date,
+// time.Time, their Value types, time.Time of standard library and strings "2006-01-02 15:04:05" or "2006-01-02"
+func toTime(value any) (goTime.Time, error) {
+	if v := reflect.ValueOf(value); v.Kind() == reflect.Pointer && v.IsNil() {
+		return goTime.Time{}, fmt.Errorf("%w nil %T, this value must be date or time", datetime.ErrUnsupportedType, value)
+	}
+
+	switch v := value.(type) {
+	case datetime.Interface:
+		return v.Time(), nil
+	case datetime.DateTime:
+		return v.Time(), nil
+	case date.Date:
+		return v.Time(), nil
+	case time.Time:
+		return v.Time(), nil
+	case goTime.Time:
+		return v, nil
+	case dateValue:
+		if d := v.Date(); d != nil {
+			return d.Time(), nil
+		}
+		return goTime.Time{}, fmt.Errorf("%w: this value must be valid date \"%v\"", ErrViolation, value)
+	case string:
+		return parseString(v)
+	}
+
+	return goTime.Time{}, fmt.Errorf("%w %T, this value must be date or time", datetime.ErrUnsupportedType, value)
+}
+
+func parseString(value string) (goTime.Time, error) {
+	if d, err := datetime.ParseString(value); err == nil {
+		return d.Time(), nil
+	}
+
+	d, err := date.ParseString(value)
+
+	if err != nil {
+		return goTime.Time{}, err
+	}
+
+	return d.Time(), nil
+}
+
+// reference returns time of value or current time of clock when value is nil
+func reference(value datetime.Interface) goTime.Time {
+	if value == nil {
+		return datetime.Now().Time()
+	}
+
+	return value.Time()
+}
+
+func violation(format string, args ...any) error {
+	return fmt.Errorf("%w: "+format, append([]any{ErrViolation}, args...)...)
+}
+
+func day(t goTime.Time) goTime.Time {
+	return datetime.GetDate(t.Year(), int(t.Month()), t.Day())
+}
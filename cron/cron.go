@@ -0,0 +1,362 @@
+package cron
+
+import (
+	"fmt"
+	"github.com/gouef/datetime"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// macros expressions of "@daily"-style macros
+var macros = map[string]string{
+	"@yearly":   "0 0 1 1 *",
+	"@annually": "0 0 1 1 *",
+	"@monthly":  "0 0 1 * *",
+	"@weekly":   "0 0 * * 0",
+	"@daily":    "0 0 * * *",
+	"@midnight": "0 0 * * *",
+	"@hourly":   "0 * * * *",
+}
+
+var (
+	monthNames   = []string{"JAN", "FEB", "MAR", "APR", "MAY", "JUN", "JUL", "AUG", "SEP", "OCT", "NOV", "DEC"}
+	weekdayNames = []string{"SUN", "MON", "TUE", "WED", "THU", "FRI", "SAT"}
+)
+
+// bounds values of field, names are names of values from min
+type bounds struct {
+	name  string
+	min   int
+	max   int
+	names []string
+}
+
+var (
+	secondBounds  = bounds{name: "second", min: 0, max: 59}
+	minuteBounds  = bounds{name: "minute", min: 0, max: 59}
+	hourBounds    = bounds{name: "hour", min: 0, max: 23}
+	dayBounds     = bounds{name: "day of month", min: 1, max: 31}
+	monthBounds   = bounds{name: "month", min: 1, max: 12, names: monthNames}
+	weekdayBounds = bounds{name: "day of week", min: 0, max: 7, names: weekdayNames}
+)
+
+type kind int
+
+const (
+	// kindRange from-to/step, single value has from equal to to
+	kindRange kind = iota
+	// kindLast "L" or "L-3", the last day of month minus from
+	kindLast
+	// kindLastWeekday "LW", the last weekday of month
+	kindLastWeekday
+	// kindNearest "15W", weekday nearest to day from
+	kindNearest
+	// kindLastOf "5L", the last weekday from of month
+	kindLastOf
+	// kindNth "5#3", step-th weekday from of month
+	kindNth
+)
+
+// item part of field between commas
+type item struct {
+	kind kind
+	from int
+	to   int
+	step int
+	// star item is "*" or "*/step"
+	star bool
+}
+
+// field parsed field of expression
+type field struct {
+	items []item
+	bits  uint64
+	// any field starts with "*" or is "?" like in Vixie cron, day of month and day of week are combined by OR when both are not any
+	any bool
+}
+
+// every returns true when field matches every value, e.g. "*" or "*/1"
+func (f field) every() bool {
+	return f.any && len(f.items) == 1 && f.items[0].star && f.items[0].step == 1
+}
+
+// Schedule parsed cron expression, it is immutable and safe to share between goroutines
+type Schedule struct {
+	expression  string
+	withSeconds bool
+	second      field
+	minute      field
+	hour        field
+	day         field
+	month       field
+	weekday     field
+	location    *time.Location
+}
+
+// Parse parses cron expression of 5 fields "minute hour day-of-month month day-of-week", 6 fields with leading second,
+// or macro "@yearly", "@annually", "@monthly", "@weekly", "@daily", "@midnight" and "@hourly".
+// Expression can start with time zone "CRON_TZ=Europe/Prague " or "TZ=Europe/Prague ".
+// Fields support lists "1,15", ranges "1-5", steps "*/15", "1-30/5" and "5/15", names "JAN" and "MON",
+// "?" in days, "L", "L-3", "LW" and "15W" in day of month, "5L" and "5#3" in day of week
+func Parse(expression string) (*Schedule, error) {
+	s := &Schedule{expression: expression}
+	value, offset := expression, 0
+
+	if prefix, zone, ok := cutTimeZone(value); ok {
+		location, err := time.LoadLocation(zone)
+
+		if err != nil {
+			return nil, &datetime.ParseError{Input: expression, Position: len(prefix), Expected: "known time zone", Err: err}
+		}
+
+		s.location = location
+		rest := strings.TrimLeft(value[len(prefix)+len(zone):], " \t")
+		offset, value = len(value)-len(rest), rest
+	}
+
+	if strings.HasPrefix(value, "@") {
+		macro, ok := macros[strings.ToLower(strings.TrimSpace(value))]
+
+		if !ok {
+			return nil, &datetime.ParseError{Input: expression, Position: offset, Expected: "macro @yearly, @annually, @monthly, @weekly, @daily, @midnight or @hourly"}
+		}
+
+		value = macro
+	}
+
+	fields, positions := split(value)
+
+	switch len(fields) {
+	case 5:
+		s.second = field{items: []item{{from: 0, to: 0, step: 1}}, bits: 1}
+	case 6:
+		s.withSeconds = true
+	default:
+		return nil, &datetime.ParseError{Input: expression, Position: offset, Expected: "5 or 6 fields"}
+	}
+
+	targets := []*field{&s.minute, &s.hour, &s.day, &s.month, &s.weekday}
+	allBounds := []bounds{minuteBounds, hourBounds, dayBounds, monthBounds, weekdayBounds}
+
+	if s.withSeconds {
+		targets = append([]*field{&s.second}, targets...)
+		allBounds = append([]bounds{secondBounds}, allBounds...)
+	}
+
+	for i, text := range fields {
+		f, err := parseField(text, allBounds[i])
+
+		if err != nil {
+			return nil, &datetime.ParseError{Input: expression, Position: offset + positions[i], Expected: allBounds[i].name, Err: err}
+		}
+
+		*targets[i] = f
+	}
+
+	return s, nil
+}
+
+// MustParse like Parse, but panics when expression is invalid
+func MustParse(expression string) *Schedule {
+	s, err := Parse(expression)
+
+	if err != nil {
+		panic(err)
+	}
+
+	return s
+}
+
+func cutTimeZone(value string) (prefix, zone string, ok bool) {
+	for _, prefix := range []string{"CRON_TZ=", "TZ="} {
+		if strings.HasPrefix(value, prefix) {
+			zone, _, _ := strings.Cut(value[len(prefix):], " ")
+
+			return prefix, zone, true
+		}
+	}
+
+	return "", "", false
+}
+
+// split splits value by spaces and returns fields with their positions
+func split(value string) ([]string, []int) {
+	var fields []string
+	var positions []int
+
+	for i := 0; i < len(value); {
+		if value[i] == ' ' || value[i] == '\t' {
+			i++
+			continue
+		}
+
+		start := i
+
+		for i < len(value) && value[i] != ' ' && value[i] != '\t' {
+			i++
+		}
+
+		fields = append(fields, value[start:i])
+		positions = append(positions, start)
+	}
+
+	return fields, positions
+}
+
+func parseField(text string, b bounds) (field, error) {
+	f := field{any: strings.HasPrefix(text, "*") || text == "?"}
+
+	if text == "?" && b.name != dayBounds.name && b.name != weekdayBounds.name {
+		return field{}, fmt.Errorf("%w: \"?\" is allowed only in days", datetime.ErrInvalidFormat)
+	}
+
+	if text == "?" {
+		text = "*"
+	}
+
+	for _, part := range strings.Split(text, ",") {
+		i, err := parseItem(strings.ToUpper(part), b)
+
+		if err != nil {
+			return field{}, err
+		}
+
+		f.items = append(f.items, i)
+
+		if i.kind != kindRange {
+			continue
+		}
+
+		for value := i.from; value <= i.to; value += i.step {
+			bit := value
+
+			if b.name == weekdayBounds.name {
+				// Sunday is both 0 and 7
+				bit %= 7
+			}
+
+			f.bits |= 1 << uint(bit)
+		}
+	}
+
+	return f, nil
+}
+
+func parseItem(part string, b bounds) (item, error) {
+	switch {
+	case b.name == dayBounds.name && part == "LW":
+		return item{kind: kindLastWeekday}, nil
+	case b.name == dayBounds.name && strings.HasPrefix(part, "L"):
+		offset := 0
+
+		if part != "L" {
+			n, err := parseNumber(strings.TrimPrefix(part, "L-"), bounds{name: "offset of last day", min: 0, max: 30})
+
+			if err != nil || !strings.HasPrefix(part, "L-") {
+				return item{}, fmt.Errorf("%w: %q is not \"L\" or \"L-3\"", datetime.ErrInvalidFormat, part)
+			}
+
+			offset = n
+		}
+
+		return item{kind: kindLast, from: offset}, nil
+	case b.name == dayBounds.name && strings.HasSuffix(part, "W"):
+		n, err := parseNumber(strings.TrimSuffix(part, "W"), b)
+
+		return item{kind: kindNearest, from: n}, err
+	case b.name == weekdayBounds.name && strings.HasSuffix(part, "L"):
+		n, err := parseValue(strings.TrimSuffix(part, "L"), b)
+
+		return item{kind: kindLastOf, from: n % 7}, err
+	case b.name == weekdayBounds.name && strings.Contains(part, "#"):
+		weekday, nth, _ := strings.Cut(part, "#")
+		n, err := parseValue(weekday, b)
+
+		if err != nil {
+			return item{}, err
+		}
+
+		k, err := parseNumber(nth, bounds{name: "week of month", min: 1, max: 5})
+
+		return item{kind: kindNth, from: n % 7, step: k}, err
+	}
+
+	rangePart, stepPart, hasStep := strings.Cut(part, "/")
+	i := item{from: b.min, to: b.max, step: 1}
+
+	if hasStep {
+		step, err := parseNumber(stepPart, bounds{name: "step", min: 1, max: b.max - b.min + 1})
+
+		if err != nil {
+			return item{}, err
+		}
+
+		i.step = step
+	}
+
+	if rangePart == "*" {
+		i.star = true
+
+		if b.name == weekdayBounds.name {
+			// Sunday is 0, 7 would repeat it
+			i.to = 6
+		}
+
+		return i, nil
+	}
+
+	from, to, isRange := strings.Cut(rangePart, "-")
+	var err error
+
+	if i.from, err = parseValue(from, b); err != nil {
+		return item{}, err
+	}
+
+	switch {
+	case isRange:
+		if i.to, err = parseValue(to, b); err != nil {
+			return item{}, err
+		}
+
+		if i.to < i.from {
+			return item{}, fmt.Errorf("%w: range %q ends before it starts", datetime.ErrInvalidFormat, rangePart)
+		}
+	case !hasStep:
+		// single value, "5/15" is from 5 to max
+		i.to = i.from
+	}
+
+	return i, nil
+}
+
+// parseValue parses number or name of value
+func parseValue(value string, b bounds) (int, error) {
+	for i, name := range b.names {
+		if value == name {
+			return b.min + i, nil
+		}
+	}
+
+	return parseNumber(value, b)
+}
+
+func parseNumber(value string, b bounds) (int, error) {
+	n, err := strconv.Atoi(value)
+
+	if err != nil || value == "" || value[0] == '+' || value[0] == '-' {
+		return 0, fmt.Errorf("%w: %s %q is not a number", datetime.ErrInvalidFormat, b.name, value)
+	}
+
+	return n, datetime.CheckField(b.name, n, b.min, b.max)
+}
+
+// String returns expression as it was parsed
+func (s *Schedule) String() string {
+	return s.expression
+}
+
+// Location returns time zone of expression, nil when expression has no time zone
+func (s *Schedule) Location() *time.Location {
+	return s.location
+}
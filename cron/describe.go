@@ -0,0 +1,203 @@
+package cron
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var ordinals = []string{"first", "second", "third", "fourth", "fifth"}
+
+// Describe explains expression in words, e.g. "At 09:00, on Monday through Friday" for "0 9 * * 1-5"
+func (s *Schedule) Describe() string {
+	parts := []string{s.describeTime()}
+
+	day := describeDays(s.day, "day", "days", describeMonthDay, number)
+	weekday := describeDays(s.weekday, "", "days of the week", describeWeekday, weekdayName)
+
+	switch {
+	case day != "" && weekday != "" && (s.day.any || s.weekday.any):
+		parts = append(parts, "on "+day+" of the month and on "+weekday)
+	case day != "" && weekday != "":
+		parts = append(parts, "on "+day+" of the month or on "+weekday)
+	case day != "":
+		parts = append(parts, "on "+day+" of the month")
+	case weekday != "":
+		parts = append(parts, "on "+weekday)
+	}
+
+	switch month := describeValues(s.month, "", "months", monthName); {
+	case s.month.every():
+	case strings.HasPrefix(month, "every"):
+		parts = append(parts, month)
+	default:
+		parts = append(parts, "in "+month)
+	}
+
+	if s.location != nil {
+		parts = append(parts, "in time zone "+s.location.String())
+	}
+
+	description := strings.Join(parts, ", ")
+
+	return strings.ToUpper(description[:1]) + description[1:]
+}
+
+func (s *Schedule) describeTime() string {
+	second, minute, hour := single(s.second), single(s.minute), single(s.hour)
+
+	if second >= 0 && minute >= 0 && hour >= 0 {
+		if second == 0 {
+			return fmt.Sprintf("at %02d:%02d", hour, minute)
+		}
+
+		return fmt.Sprintf("at %02d:%02d:%02d", hour, minute, second)
+	}
+
+	var parts []string
+
+	if s.withSeconds && second != 0 {
+		parts = append(parts, describeUnit(s.second, "second", "seconds", number))
+	}
+
+	if !(s.minute.every() && len(parts) > 0) {
+		parts = append(parts, describeUnit(s.minute, "minute", "minutes", number))
+	}
+
+	if !s.hour.every() {
+		parts = append(parts, describeUnit(s.hour, "hour", "hours", twoDigits))
+	}
+
+	if !strings.HasPrefix(parts[0], "every") {
+		parts[0] = "at " + parts[0]
+	}
+
+	return strings.Join(parts, " past ")
+}
+
+// single returns value of field of single value, -1 otherwise
+func single(f field) int {
+	if len(f.items) == 1 && f.items[0].kind == kindRange && !f.items[0].star && f.items[0].from == f.items[0].to {
+		return f.items[0].from
+	}
+
+	return -1
+}
+
+// describeUnit returns e.g. "every minute", "every 15 minutes" or "minutes 0 and 30"
+func describeUnit(f field, unit, units string, name func(int) string) string {
+	if f.every() {
+		return "every " + unit
+	}
+
+	if len(f.items) == 1 && f.items[0].star {
+		return fmt.Sprintf("every %d %s", f.items[0].step, units)
+	}
+
+	return describeValues(f, unit, units, name)
+}
+
+// describeValues returns e.g. "minute 5", "minutes 0 through 10 and 30", or "January through March" when unit is empty
+func describeValues(f field, unit, units string, name func(int) string) string {
+	items := make([]string, len(f.items))
+
+	for i, it := range f.items {
+		items[i] = describeRange(it, units, name)
+	}
+
+	if unit == "" {
+		return join(items)
+	}
+
+	if len(f.items) == 1 && f.items[0].from == f.items[0].to && !f.items[0].star {
+		return unit + " " + items[0]
+	}
+
+	return units + " " + join(items)
+}
+
+func describeRange(i item, units string, name func(int) string) string {
+	switch {
+	case i.star:
+		return fmt.Sprintf("every %d %s", i.step, units)
+	case i.from == i.to:
+		return name(i.from)
+	case i.step > 1:
+		return fmt.Sprintf("every %d %s from %s through %s", i.step, units, name(i.from), name(i.to))
+	default:
+		return name(i.from) + " through " + name(i.to)
+	}
+}
+
+// describeDays describes day of month or day of week, it is empty when field matches every day
+func describeDays(f field, unit, units string, special func(item) string, name func(int) string) string {
+	if f.every() {
+		return ""
+	}
+
+	var items []string
+	var values []item
+
+	for _, i := range f.items {
+		if i.kind == kindRange {
+			values = append(values, i)
+		} else {
+			items = append(items, special(i))
+		}
+	}
+
+	if len(values) > 0 {
+		items = append([]string{describeValues(field{items: values}, unit, units, name)}, items...)
+	}
+
+	return join(items)
+}
+
+func describeMonthDay(i item) string {
+	switch i.kind {
+	case kindLast:
+		if i.from == 0 {
+			return "the last day"
+		}
+
+		return fmt.Sprintf("%d days before the last day", i.from)
+	case kindLastWeekday:
+		return "the last weekday"
+	default:
+		return fmt.Sprintf("the weekday nearest day %d", i.from)
+	}
+}
+
+func describeWeekday(i item) string {
+	if i.kind == kindLastOf {
+		return "the last " + weekdayName(i.from) + " of the month"
+	}
+
+	return "the " + ordinals[i.step-1] + " " + weekdayName(i.from) + " of the month"
+}
+
+func number(value int) string {
+	return strconv.Itoa(value)
+}
+
+func twoDigits(value int) string {
+	return fmt.Sprintf("%02d", value)
+}
+
+func monthName(value int) string {
+	return time.Month(value).String()
+}
+
+func weekdayName(value int) string {
+	return time.Weekday(value % 7).String()
+}
+
+// join returns "a", "a and b" or "a, b and c"
+func join(items []string) string {
+	if len(items) < 2 {
+		return strings.Join(items, "")
+	}
+
+	return strings.Join(items[:len(items)-1], ", ") + " and " + items[len(items)-1]
+}
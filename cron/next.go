@@ -0,0 +1,209 @@
+package cron
+
+import (
+	"context"
+	"github.com/gouef/datetime"
+	"github.com/gouef/datetime/internal/wallclock"
+	"iter"
+	"time"
+)
+
+// maxYears years searched for fire time, the calendar repeats every 28 years, so that expressions
+// like "0 0 30 2 *" which never fire end there
+const maxYears = 28
+
+// Next returns the first fire time after t in time zone of expression, or in location of t when expression has no time zone.
+// Wall clock times skipped by daylight saving time fire once at the first instant after the gap and repeated ones fire once.
+// It returns zero time when expression never fires
+func (s *Schedule) Next(t time.Time) time.Time {
+	loc := s.locationOf(t)
+	t = t.In(loc)
+	w := wallclock.Of(t).Truncate(time.Second).Add(time.Second)
+	limit := w.Year() + maxYears
+
+	for w.Year() <= limit {
+		switch {
+		case !s.month.has(int(w.Month())):
+			w = time.Date(w.Year(), w.Month()+1, 1, 0, 0, 0, 0, time.UTC)
+		case !s.matchesDay(w):
+			w = time.Date(w.Year(), w.Month(), w.Day()+1, 0, 0, 0, 0, time.UTC)
+		case !s.hour.has(w.Hour()):
+			w = w.Truncate(time.Hour).Add(time.Hour)
+		case !s.minute.has(w.Minute()):
+			w = w.Truncate(time.Minute).Add(time.Minute)
+		case !s.second.has(w.Second()):
+			w = w.Add(time.Second)
+		default:
+			if fire := inLocation(w, loc); fire.After(t) {
+				return fire
+			}
+
+			w = w.Add(time.Second)
+		}
+	}
+
+	return time.Time{}
+}
+
+// Previous returns the last fire time before t, see Next
+func (s *Schedule) Previous(t time.Time) time.Time {
+	loc := s.locationOf(t)
+	t = t.In(loc)
+	w := wallclock.Of(t).Add(-time.Nanosecond).Truncate(time.Second)
+	limit := w.Year() - maxYears
+
+	for w.Year() >= limit {
+		switch {
+		case !s.month.has(int(w.Month())):
+			w = time.Date(w.Year(), w.Month(), 1, 0, 0, 0, 0, time.UTC).Add(-time.Second)
+		case !s.matchesDay(w):
+			w = time.Date(w.Year(), w.Month(), w.Day(), 0, 0, 0, 0, time.UTC).Add(-time.Second)
+		case !s.hour.has(w.Hour()):
+			w = w.Truncate(time.Hour).Add(-time.Second)
+		case !s.minute.has(w.Minute()):
+			w = w.Truncate(time.Minute).Add(-time.Second)
+		case !s.second.has(w.Second()):
+			w = w.Add(-time.Second)
+		default:
+			if fire := inLocation(w, loc); fire.Before(t) {
+				return fire
+			}
+
+			w = w.Add(-time.Second)
+		}
+	}
+
+	return time.Time{}
+}
+
+// Between returns fire times in r as wall clock in time zone of expression, or in UTC when expression has no time zone.
+// Fire times are in r by Range.Is, range without from starts now and range without to is endless,
+// fire times before year 0 are skipped
+func (s *Schedule) Between(r *datetime.Range) iter.Seq[datetime.DateTime] {
+	loc := s.locationOf(time.Time{})
+	from, errFrom := datetime.ParseString(string(r.From().(datetime.Value)))
+	to, errTo := datetime.ParseString(string(r.To().(datetime.Value)))
+	bounded := r.To().(datetime.Value) != "" && errTo == nil
+
+	start := datetime.ClockFromContext(context.Background()).Now().In(loc)
+
+	if r.From().(datetime.Value) != "" && errFrom == nil {
+		start = time.Date(from.Year(), time.Month(from.Month()), from.Day(), from.Hour(), from.Minute(), from.Second(), 0, loc)
+	}
+
+	return func(yield func(datetime.DateTime) bool) {
+		for fire := s.Next(start.Add(-time.Nanosecond)); !fire.IsZero(); fire = s.Next(fire) {
+			d, err := datetime.New(fire.Year(), int(fire.Month()), fire.Day(), fire.Hour(), fire.Minute(), fire.Second())
+
+			// DateTime can not hold fire times before year 0
+			if err != nil {
+				continue
+			}
+
+			if bounded && d.After(to) {
+				return
+			}
+
+			if r.Is(*d) && !yield(*d) {
+				return
+			}
+		}
+	}
+}
+
+func (s *Schedule) locationOf(t time.Time) *time.Location {
+	if s.location != nil {
+		return s.location
+	}
+
+	return t.Location()
+}
+
+func (s *Schedule) matchesDay(w time.Time) bool {
+	if s.day.any || s.weekday.any {
+		return s.matchesMonthDay(w) && s.matchesWeekday(w)
+	}
+
+	return s.matchesMonthDay(w) || s.matchesWeekday(w)
+}
+
+func (s *Schedule) matchesMonthDay(w time.Time) bool {
+	if s.day.has(w.Day()) {
+		return true
+	}
+
+	last := datetime.DaysInMonth(w.Year(), int(w.Month()))
+
+	for _, i := range s.day.items {
+		switch {
+		case i.kind == kindLast && w.Day() == last-i.from:
+			return true
+		case i.kind == kindLastWeekday && w.Day() == nearestWeekday(w, last):
+			return true
+		case i.kind == kindNearest && i.from <= last && w.Day() == nearestWeekday(w, i.from):
+			return true
+		}
+	}
+
+	return false
+}
+
+func (s *Schedule) matchesWeekday(w time.Time) bool {
+	if s.weekday.has(int(w.Weekday())) {
+		return true
+	}
+
+	last := datetime.DaysInMonth(w.Year(), int(w.Month()))
+
+	for _, i := range s.weekday.items {
+		if i.from != int(w.Weekday()) {
+			continue
+		}
+
+		switch {
+		case i.kind == kindLastOf && w.Day()+7 > last:
+			return true
+		case i.kind == kindNth && (w.Day()-1)/7+1 == i.step:
+			return true
+		}
+	}
+
+	return false
+}
+
+// nearestWeekday returns Monday to Friday nearest to day of month of w, it does not leave the month
+func nearestWeekday(w time.Time, day int) int {
+	last := datetime.DaysInMonth(w.Year(), int(w.Month()))
+
+	switch time.Date(w.Year(), w.Month(), day, 0, 0, 0, 0, time.UTC).Weekday() {
+	case time.Saturday:
+		if day == 1 {
+			return 3
+		}
+
+		return day - 1
+	case time.Sunday:
+		if day == last {
+			return day - 2
+		}
+
+		return day + 1
+	default:
+		return day
+	}
+}
+
+func (f field) has(value int) bool {
+	return f.bits&(1<<uint(value)) != 0
+}
+
+// inLocation returns wall clock w in loc, w skipped by daylight saving time is the first instant after the gap
+func inLocation(w time.Time, loc *time.Location) time.Time {
+	t := wallclock.In(w, loc)
+
+	if !wallclock.Of(t).Equal(w) {
+		t, _ = t.ZoneBounds()
+	}
+
+	return t
+}
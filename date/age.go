@@ -0,0 +1,125 @@
+package date
+
+import (
+	"fmt"
+	"github.com/gouef/datetime"
+	"time"
+)
+
+// LeapDayPolicy anniversary of February 29 in common years, and of other days missing in shorter months
+type LeapDayPolicy int
+
+const (
+	// LeapDayMarch1 anniversary is the next day, March 1
+	LeapDayMarch1 LeapDayPolicy = iota
+	// LeapDayFebruary28 anniversary is the last day of month, February 28
+	LeapDayFebruary28
+)
+
+// Period years, months and days between dates
+type Period struct {
+	Years  int
+	Months int
+	Days   int
+}
+
+// String returns ISO 8601 duration, e.g. "P18Y2M5D"
+func (p Period) String() string {
+	return fmt.Sprintf("P%dY%dM%dD", p.Years, p.Months, p.Days)
+}
+
+// Age returns age in whole years at date at, birthday of February 29 is reached on March 1 in common years
+func Age(birth, at Date) int {
+	return AgeWithPolicy(birth, at, LeapDayMarch1)
+}
+
+// AgeWithPolicy returns age in whole years at date at, 0 when at is before birth
+func AgeWithPolicy(birth, at Date, policy LeapDayPolicy) int {
+	years := at.Year() - birth.Year()
+
+	if at.Before(Anniversary(birth, at.Year(), policy)) {
+		years--
+	}
+
+	return max(years, 0)
+}
+
+// AgePeriod returns age in years, months and days at date at, zero Period when at is before birth
+func AgePeriod(birth, at Date, policy LeapDayPolicy) Period {
+	if at.Before(birth) {
+		return Period{}
+	}
+
+	months := (at.Year()-birth.Year())*12 + at.Month() - birth.Month()
+
+	if at.Before(addMonths(birth, months, policy)) {
+		months--
+	}
+
+	return Period{
+		Years:  months / 12,
+		Months: months % 12,
+		Days:   DaysBetween(addMonths(birth, months, policy), at),
+	}
+}
+
+// Anniversary returns anniversary of birth in year
+func Anniversary(birth Date, year int, policy LeapDayPolicy) Date {
+	return addMonths(birth, (year-birth.Year())*12, policy)
+}
+
+// NextAnniversary returns the first anniversary of birth on or after from, birth itself when from is before it
+func NextAnniversary(birth, from Date, policy LeapDayPolicy) Date {
+	if from.Before(birth) {
+		return birth
+	}
+
+	anniversary := Anniversary(birth, from.Year(), policy)
+
+	if anniversary.Before(from) {
+		return Anniversary(birth, from.Year()+1, policy)
+	}
+
+	return anniversary
+}
+
+// PreviousAnniversary returns the last anniversary of birth on or before from, birth itself when from is before it
+func PreviousAnniversary(birth, from Date, policy LeapDayPolicy) Date {
+	if from.Before(birth) {
+		return birth
+	}
+
+	anniversary := Anniversary(birth, from.Year(), policy)
+
+	if anniversary.After(from) {
+		return Anniversary(birth, from.Year()-1, policy)
+	}
+
+	return anniversary
+}
+
+// DaysUntilBirthday returns days from from to the next birthday, 0 on birthday
+func DaysUntilBirthday(birth, from Date, policy LeapDayPolicy) int {
+	return DaysBetween(from, NextAnniversary(birth, from, policy))
+}
+
+// TurnsOn returns date when person born on birth turns age
+func TurnsOn(birth Date, age int, policy LeapDayPolicy) Date {
+	return Anniversary(birth, birth.Year()+age, policy)
+}
+
+// addMonths adds n months to d, day missing in target month is resolved by policy
+func addMonths(d Date, n int, policy LeapDayPolicy) Date {
+	first := time.Date(d.Year(), time.Month(d.Month())+time.Month(n), 1, 0, 0, 0, 0, time.UTC)
+	days := datetime.DaysInMonthByDate(first)
+
+	if d.Day() <= days {
+		return Date{dateTime: first.AddDate(0, 0, d.Day()-1)}
+	}
+
+	if policy == LeapDayFebruary28 {
+		return Date{dateTime: first.AddDate(0, 0, days-1)}
+	}
+
+	return Date{dateTime: first.AddDate(0, 1, 0)}
+}
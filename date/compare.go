@@ -0,0 +1,18 @@
+package date
+
+import (
+	"github.com/gouef/datetime"
+)
+
+func (d Date) HasDate() bool {
+	return true
+}
+
+func (d Date) HasTime() bool {
+	return false
+}
+
+// CompareAt compares d with u up to granularity g, granularity finer than day returns error, see datetime.CompareAt
+func (d Date) CompareAt(u datetime.Interface, g datetime.Granularity) (int, error) {
+	return datetime.CompareAt(d, u, g)
+}
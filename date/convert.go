@@ -0,0 +1,122 @@
+package date
+
+import (
+	"errors"
+	"github.com/gouef/datetime"
+	timeOfDay "github.com/gouef/datetime/time"
+	"iter"
+	"time"
+)
+
+// ErrUnboundedRange range without from can not be iterated
+var ErrUnboundedRange = errors.New("range without from can not be iterated")
+
+// ErrRangeWithoutTo range without to has no end to count or convert to
+var ErrRangeWithoutTo = errors.New("range without to has no end")
+
+// FromDateTime returns date of d, it is a function instead of method DateTime.Date,
+// because package datetime can not import this package
+func FromDateTime(d datetime.DateTime) Date {
+	return Date{dateTime: datetime.StartOfDay(d.Time())}
+}
+
+// At returns date time of d at time of day t in location loc converted to UTC, nil loc is UTC.
+// Time of day skipped by daylight saving time is normalized like time.Date does
+func (d Date) At(t timeOfDay.Time, loc *time.Location) (datetime.DateTime, error) {
+	if loc == nil {
+		loc = time.UTC
+	}
+
+	at := time.Date(d.Year(), time.Month(d.Month()), d.Day(), t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), loc).UTC()
+	result, err := datetime.NewNano(at.Year(), int(at.Month()), at.Day(), at.Hour(), at.Minute(), at.Second(), at.Nanosecond())
+
+	if err != nil {
+		return datetime.DateTime{}, err
+	}
+
+	return *result, nil
+}
+
+// Windows returns date time ranges of daily hours on every day of days accepted by include (nil accepts all days),
+// e.g. 09:00-17:00 of every weekday in March. Both from and to of days are included, the sequence is endless
+// when days has no to. Empty from of hours is midnight, empty to is the end of day and hours with to before from
+// end on the next day. Ranges have brackets of hours and are in UTC, times of day are in location loc
+func Windows(days *Range, hours *timeOfDay.Range, loc *time.Location, include func(Date) bool) (iter.Seq[*datetime.Range], error) {
+	if days.From() == "" {
+		return nil, ErrUnboundedRange
+	}
+
+	first, err := ParseString(string(days.From()))
+
+	if err != nil {
+		return nil, err
+	}
+
+	var last *Date
+
+	if days.To() != "" {
+		to, err := ParseString(string(days.To()))
+
+		if err != nil {
+			return nil, err
+		}
+
+		last = &to
+	}
+
+	var start, end timeOfDay.Time
+	hoursFrom, hoursTo := string(hours.From().(timeOfDay.Value)), string(hours.To().(timeOfDay.Value))
+
+	if hoursFrom != "" {
+		if start, err = timeOfDay.ParseString(hoursFrom); err != nil {
+			return nil, err
+		}
+	}
+
+	endOffset := 0
+
+	if hoursTo == "" {
+		end = timeOfDay.FromDateTime(first.EndOfDay())
+	} else if end, err = timeOfDay.ParseString(hoursTo); err != nil {
+		return nil, err
+	}
+
+	if end.Before(start) {
+		endOffset = 1
+	}
+
+	return func(yield func(*datetime.Range) bool) {
+		for day := first; last == nil || !day.After(last); day = day.addDays(1) {
+			if include != nil && !include(day) {
+				continue
+			}
+
+			from, err := day.At(start, loc)
+
+			if err != nil {
+				continue
+			}
+
+			to, err := day.addDays(endOffset).At(end, loc)
+
+			if err != nil {
+				continue
+			}
+
+			window, _ := datetime.NewRange(from.ToString(), to.ToString(), hours.Start(), hours.End())
+
+			if !yield(window) {
+				return
+			}
+		}
+	}, nil
+}
+
+func (d Date) addDays(n int) Date {
+	return Date{dateTime: d.dateTime.AddDate(0, 0, n)}
+}
+
+// FromTime returns date of wall clock of t
+func FromTime(t time.Time) Date {
+	return Date{dateTime: time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)}
+}
@@ -1,118 +1,201 @@
 package date
 
 import (
-	"errors"
-	"fmt"
+	"context"
 	"github.com/gouef/datetime"
+	"github.com/gouef/datetime/internal/scan"
 	"github.com/gouef/utils"
-	"github.com/gouef/validator"
-	"github.com/gouef/validator/constraints"
-	"regexp"
-	"strconv"
+	"math"
 	"time"
 )
 
 const (
 	Regexp         = `^(\d{4})-(\d{2})-(\d{2})?$`
-	DateTimeRegexp = `^(((\d{4})-(\d{2})-(\d{2}))( )?)((\d{2}):(\d{2}):(\d{2}))?$`
+	DateTimeRegexp = `^(((\d{4})-(\d{2})-(\d{2}))( )?)((\d{2}):(\d{2}):(\d{2})(?:\.\d{1,9})?)?$`
 )
 
+// Date date without time of day, it is immutable and safe to share between goroutines.
+// Create it by New, FromString or Now, zero value is "0001-01-01", see IsZero
 type Date struct {
-	Year     int
-	Month    int `validate:"min=1,max=12"`
-	Day      int `validate:"min=1,max=31"`
-	DateTime time.Time
+	dateTime time.Time
 }
 
+// Now current date of clock set by SetClock
 func Now() *Date {
-	now := time.Now()
+	return NowContext(context.Background())
+}
 
-	return &Date{
-		Year:     now.Year(),
-		Month:    int(now.Month()),
-		Day:      now.Day(),
-		DateTime: now,
-	}
+// NowContext current date of clock carried by ctx, see WithClock
+func NowContext(ctx context.Context) *Date {
+	now := datetime.ClockFromContext(ctx).Now()
+
+	return &Date{dateTime: time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)}
 }
 
 func New(year, month, day int) (datetime.Interface, error) {
-	errs := validator.Validate(year, constraints.GreaterOrEqual{Value: 0})
+	d, err := newDate(year, month, day)
 
-	if len(errs) > 0 {
-		return nil, errors.New(fmt.Sprintf("year must be 0 or greater get \"%d\"", year))
+	if err != nil {
+		return nil, err
 	}
 
-	errs = validator.Validate(month, constraints.Range{Min: 1, Max: 12})
+	return &d, nil
+}
+
+// Validate checks ranges of fields including days in month. It is kept for struct validation by validators,
+// values of constructors are always valid and only conversions can move them out of range, e.g. FromTime of year -1
+func (d Date) Validate() error {
+	_, err := newDate(d.Year(), d.Month(), d.Day())
+	return err
+}
+
+// newDate validates fields and returns Date by value, so that ParseString does not allocate
+func newDate(year, month, day int) (Date, error) {
+	if year < 0 {
+		return Date{}, &datetime.FieldRangeError{Field: "year", Value: year, Min: 0, Max: math.MaxInt}
+	}
 
-	if len(errs) > 0 {
-		return nil, errors.New(fmt.Sprintf("month must be between 1-12 get \"%d\"", month))
+	if err := datetime.CheckField("month", month, 1, 12); err != nil {
+		return Date{}, err
 	}
-	daysInMonth := datetime.DaysInMonth(year, month)
-	errs = validator.Validate(day, constraints.Range{Min: 1, Max: float64(daysInMonth)})
 
-	if len(errs) > 0 {
-		return nil, errors.New(fmt.Sprintf("day must be between 1-%d for month %d of year %d get \"%d\"", daysInMonth, month, year, day))
+	if err := datetime.CheckField("day", day, 1, datetime.DaysInMonth(year, month)); err != nil {
+		return Date{}, err
 	}
 
-	return &Date{
-		Year:     year,
-		Month:    month,
-		Day:      day,
-		DateTime: time.Date(year, time.Month(month), day, 0, 0, 0, 0, time.UTC),
-	}, nil
+	return Date{dateTime: time.Date(year, time.Month(month), day, 0, 0, 0, 0, time.UTC)}, nil
 }
 
 func FromString(value string) (datetime.Interface, error) {
-	errs := validator.Validate(value, constraints.RegularExpression{Regexp: DateTimeRegexp})
+	d, err := ParseString(value)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &d, nil
+}
+
+// ParseString parses "2006-01-02", optionally followed by time "15:04:05" which is ignored,
+// like FromString, but returns Date by value and does not allocate unless value is invalid
+func ParseString(value string) (Date, error) {
+	year, ok := scan.Digits(value, 0, 4)
+
+	if !ok {
+		return Date{}, &datetime.ParseError{Input: value, Expected: "year of 4 digits"}
+	}
+
+	if len(value) <= 4 || value[4] != '-' {
+		return Date{}, &datetime.ParseError{Input: value, Position: 4, Expected: "\"-\""}
+	}
+
+	month, ok := scan.Digits(value, 5, 2)
+
+	if !ok {
+		return Date{}, &datetime.ParseError{Input: value, Position: 5, Expected: "month of 2 digits"}
+	}
+
+	if len(value) <= 7 || value[7] != '-' {
+		return Date{}, &datetime.ParseError{Input: value, Position: 7, Expected: "\"-\""}
+	}
+
+	day, ok := scan.Digits(value, 8, 2)
 
-	if len(errs) != 0 {
-		return nil, errors.New(fmt.Sprintf("unsupported format of date \"%s\"", value))
+	if !ok {
+		return Date{}, &datetime.ParseError{Input: value, Position: 8, Expected: "day of 2 digits"}
 	}
 
-	re := regexp.MustCompile(DateTimeRegexp)
-	match := re.FindStringSubmatch(value)
-	year, _ := strconv.Atoi(match[3])
-	month, _ := strconv.Atoi(match[4])
-	day, _ := strconv.Atoi(match[5])
+	if i := skipTime(value, 10); i != len(value) {
+		return Date{}, &datetime.ParseError{Input: value, Position: i, Expected: "end of value or time \"15:04:05\""}
+	}
+
+	return newDate(year, month, day)
+}
+
+// skipTime skips optional space and optional time "15:04:05.999999999" at value[i:]
+func skipTime(value string, i int) int {
+	if i < len(value) && value[i] == ' ' {
+		i++
+	}
+
+	if len(value)-i < 8 || value[i+2] != ':' || value[i+5] != ':' {
+		return i
+	}
+
+	for _, n := range [...]int{0, 3, 6} {
+		if _, ok := scan.Digits(value, i+n, 2); !ok {
+			return i
+		}
+	}
 
-	return New(year, month, day)
+	if _, next, ok := scan.Fraction(value, i+8); ok {
+		return next
+	}
+
+	return i + 8
 }
 
-func (d *Date) FromString(value string) (datetime.Interface, error) {
+func (d Date) FromString(value string) (datetime.Interface, error) {
 	return FromString(value)
 }
 
-func (d *Date) ToString() string {
+func (d Date) ToString() string {
 	return d.Time().Format(time.DateOnly)
 }
 
-func (d *Date) IsWeekend() bool {
+func (d Date) Year() int {
+	return d.dateTime.Year()
+}
+
+func (d Date) Month() int {
+	return int(d.dateTime.Month())
+}
+
+func (d Date) Day() int {
+	return d.dateTime.Day()
+}
+
+func (d Date) Weekday() time.Weekday {
+	return d.dateTime.Weekday()
+}
+
+// IsZero reports whether d is zero value, "0001-01-01"
+func (d Date) IsZero() bool {
+	return d.dateTime.IsZero()
+}
+
+// DaysBetween returns number of days from start to end, negative when end is before start
+func DaysBetween(start, end Date) int {
+	return int((end.dateTime.Unix() - start.dateTime.Unix()) / 86400)
+}
+
+func (d Date) IsWeekend() bool {
 	weekendDays := []time.Weekday{time.Sunday, time.Saturday}
-	return utils.InArray(d.DateTime.Weekday(), weekendDays)
+	return utils.InArray(d.Weekday(), weekendDays)
 }
 
-func (d *Date) Time() time.Time {
-	return time.Date(d.Year, time.Month(d.Month), d.Day, 0, 0, 0, 0, time.UTC)
+func (d Date) Time() time.Time {
+	return d.dateTime
 }
 
 // Compare compares the date instant d with u. If d is before u, it returns -1;
 // if d is after u, it returns +1; if they're the same, it returns 0.
-func (d *Date) Compare(u datetime.Interface) int {
+func (d Date) Compare(u datetime.Interface) int {
 	return d.Time().Compare(u.Time())
 }
 
-func (d *Date) Equal(u datetime.Interface) bool {
+func (d Date) Equal(u datetime.Interface) bool {
 	return d.Time().Equal(u.Time())
 }
 
-func (d *Date) Between(start, end datetime.Interface) bool {
+func (d Date) Between(start, end datetime.Interface) bool {
 	return d.Before(end) && d.After(start)
 }
 
-func (d *Date) Before(u datetime.Interface) bool {
+func (d Date) Before(u datetime.Interface) bool {
 	return d.Time().Before(u.Time())
 }
 
-func (d *Date) After(u datetime.Interface) bool {
+func (d Date) After(u datetime.Interface) bool {
 	return d.Time().After(u.Time())
 }
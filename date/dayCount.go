@@ -0,0 +1,233 @@
+package date
+
+import (
+	"errors"
+	"fmt"
+	"github.com/gouef/datetime"
+	"time"
+)
+
+// ErrInvalidDayCount unknown day count convention or options it requires are missing
+var ErrInvalidDayCount = errors.New("invalid day count convention")
+
+// DayCount day count convention of interest accrual
+type DayCount int
+
+const (
+	// Thirty360US 30/360 US, last day of February counts as 30th
+	Thirty360US DayCount = iota
+	// Thirty360European 30E/360, 31st counts as 30th
+	Thirty360European
+	// Thirty360ISDA 30E/360 ISDA, last day of month counts as 30th except February at maturity
+	Thirty360ISDA
+	// Actual360 ACT/360
+	Actual360
+	// Actual365Fixed ACT/365F
+	Actual365Fixed
+	// ActualActualISDA ACT/ACT ISDA, days in leap years are divided by 366, other days by 365
+	ActualActualISDA
+	// ActualActualICMA ACT/ACT ICMA, days are divided by days of coupon period times frequency
+	ActualActualICMA
+	// Business252 BUS/252, business days are divided by 252
+	Business252
+)
+
+var dayCountNames = map[DayCount]string{
+	Thirty360US:       "30/360 US",
+	Thirty360European: "30E/360",
+	Thirty360ISDA:     "30E/360 ISDA",
+	Actual360:         "ACT/360",
+	Actual365Fixed:    "ACT/365F",
+	ActualActualISDA:  "ACT/ACT ISDA",
+	ActualActualICMA:  "ACT/ACT ICMA",
+	Business252:       "BUS/252",
+}
+
+func (c DayCount) String() string {
+	if name, ok := dayCountNames[c]; ok {
+		return name
+	}
+
+	return fmt.Sprintf("DayCount(%d)", int(c))
+}
+
+// DayCountOptions data required by some conventions
+type DayCountOptions struct {
+	// Maturity of 30E/360 ISDA, February at maturity is not counted as 30th, zero when unknown
+	Maturity Date
+	// Frequency coupons per year of ACT/ACT ICMA, divisor of 12
+	Frequency int
+	// CouponDate coupon date of ACT/ACT ICMA which coupon periods are aligned to, zero means end
+	CouponDate Date
+	// Calendar of BUS/252, nil means WeekendCalendar
+	Calendar datetime.BusinessCalendar
+}
+
+// YearFraction returns fraction of year between start and end by convention, negative when end is before start.
+// ACT/ACT ICMA needs Frequency, use YearFractionWithOptions
+func YearFraction(start, end Date, convention DayCount) (float64, error) {
+	return YearFractionWithOptions(start, end, convention, DayCountOptions{})
+}
+
+// YearFractionWithOptions like YearFraction with options of conventions
+func YearFractionWithOptions(start, end Date, convention DayCount, options DayCountOptions) (float64, error) {
+	if end.Before(start) {
+		fraction, err := YearFractionWithOptions(end, start, convention, options)
+		return -fraction, err
+	}
+
+	switch convention {
+	case Thirty360US:
+		return thirty360US(start, end), nil
+	case Thirty360European:
+		return thirty360European(start, end), nil
+	case Thirty360ISDA:
+		return thirty360ISDA(start, end, options.Maturity), nil
+	case Actual360:
+		return float64(DaysBetween(start, end)) / 360, nil
+	case Actual365Fixed:
+		return float64(DaysBetween(start, end)) / 365, nil
+	case ActualActualISDA:
+		return actualActualISDA(start, end), nil
+	case ActualActualICMA:
+		return actualActualICMA(start, end, options)
+	case Business252:
+		return business252(start, end, options.Calendar), nil
+	default:
+		return 0, fmt.Errorf("%w %s", ErrInvalidDayCount, convention)
+	}
+}
+
+func thirty360(start, end Date, startDay, endDay int) float64 {
+	days := 360*(end.Year()-start.Year()) + 30*(end.Month()-start.Month()) + endDay - startDay
+
+	return float64(days) / 360
+}
+
+func thirty360US(start, end Date) float64 {
+	startDay, endDay := start.Day(), end.Day()
+
+	if isLastOfFebruary(start) {
+		if isLastOfFebruary(end) {
+			endDay = 30
+		}
+
+		startDay = 30
+	}
+
+	if endDay == 31 && startDay >= 30 {
+		endDay = 30
+	}
+
+	startDay = min(startDay, 30)
+
+	return thirty360(start, end, startDay, endDay)
+}
+
+func thirty360European(start, end Date) float64 {
+	return thirty360(start, end, min(start.Day(), 30), min(end.Day(), 30))
+}
+
+func thirty360ISDA(start, end, maturity Date) float64 {
+	startDay, endDay := start.Day(), end.Day()
+
+	if isLastOfMonth(start) {
+		startDay = 30
+	}
+
+	if isLastOfMonth(end) && !(isLastOfFebruary(end) && end.Equal(maturity)) {
+		endDay = 30
+	}
+
+	return thirty360(start, end, startDay, endDay)
+}
+
+func actualActualISDA(start, end Date) float64 {
+	if start.Year() == end.Year() {
+		return float64(DaysBetween(start, end)) / daysInYear(start.Year())
+	}
+
+	startNext := Date{dateTime: datetime.GetDate(start.Year()+1, 1, 1)}
+	endFirst := Date{dateTime: datetime.GetDate(end.Year(), 1, 1)}
+
+	return float64(DaysBetween(start, startNext))/daysInYear(start.Year()) +
+		float64(end.Year()-start.Year()-1) +
+		float64(DaysBetween(endFirst, end))/daysInYear(end.Year())
+}
+
+// actualActualICMA splits start and end to coupon periods aligned to coupon date, so that stubs are counted
+// against their notional periods
+func actualActualICMA(start, end Date, options DayCountOptions) (float64, error) {
+	if options.Frequency <= 0 || 12%options.Frequency != 0 {
+		return 0, fmt.Errorf("%w %s needs frequency dividing 12, got %d", ErrInvalidDayCount, ActualActualICMA, options.Frequency)
+	}
+
+	months := 12 / options.Frequency
+	anchor := options.CouponDate
+
+	if anchor.IsZero() {
+		anchor = end
+	}
+
+	// periods are computed from anchor, so that days missing in shorter months do not shift later periods
+	n := 0
+
+	for couponDate(anchor, n, months).Before(end) {
+		n++
+	}
+
+	fraction := 0.0
+
+	for periodEnd := couponDate(anchor, n, months); periodEnd.After(start); periodEnd = couponDate(anchor, n, months) {
+		n--
+		periodStart := couponDate(anchor, n, months)
+
+		from, to := start, end
+
+		if periodStart.After(from) {
+			from = periodStart
+		}
+
+		if periodEnd.Before(to) {
+			to = periodEnd
+		}
+
+		if to.After(from) {
+			fraction += float64(DaysBetween(from, to)) / float64(options.Frequency*DaysBetween(periodStart, periodEnd))
+		}
+	}
+
+	return fraction, nil
+}
+
+func business252(start, end Date, calendar datetime.BusinessCalendar) float64 {
+	if calendar == nil {
+		calendar = datetime.WeekendCalendar()
+	}
+
+	days := 0
+
+	for t := start.Time(); t.Before(end.Time()); t = t.AddDate(0, 0, 1) {
+		if calendar.IsBusinessDay(t) {
+			days++
+		}
+	}
+
+	return float64(days) / 252
+}
+
+func couponDate(anchor Date, n, months int) Date {
+	return addMonths(anchor, n*months, LeapDayFebruary28)
+}
+
+func daysInYear(year int) float64 {
+	return float64(365 + datetime.DaysInMonth(year, 2) - 28)
+}
+
+func isLastOfMonth(d Date) bool {
+	return d.Day() == datetime.DaysInMonth(d.Year(), d.Month())
+}
+
+func isLastOfFebruary(d Date) bool {
+	return d.Month() == int(time.February) && isLastOfMonth(d)
+}
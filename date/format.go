@@ -0,0 +1,27 @@
+package date
+
+import (
+	"github.com/gouef/datetime"
+)
+
+// Format formats date by pattern
+func (d Date) Format(pattern datetime.Pattern) string {
+	return datetime.FormatTime(d.Time(), pattern)
+}
+
+// Parse parses value by pattern into Date, time fields of pattern are ignored
+func Parse(pattern datetime.Pattern, value string) (datetime.Interface, error) {
+	t, err := datetime.ParseTime(pattern, value)
+
+	if err != nil {
+		return nil, err
+	}
+
+	d, err := New(t.Year(), int(t.Month()), t.Day())
+
+	if err != nil {
+		return nil, err
+	}
+
+	return d, nil
+}
@@ -0,0 +1,39 @@
+package date
+
+import (
+	"github.com/gouef/datetime"
+	"gopkg.in/yaml.v3"
+)
+
+// Formatted Date marshaled to text, JSON and YAML by pattern of P, see datetime.WirePattern
+type Formatted[P datetime.WirePattern] struct {
+	Date
+}
+
+func (f Formatted[P]) MarshalText() ([]byte, error) {
+	var p P
+
+	return f.Date.MarshalTextPattern(p.Pattern())
+}
+
+func (f *Formatted[P]) UnmarshalText(text []byte) error {
+	var p P
+
+	return f.Date.UnmarshalTextPattern(p.Pattern(), text)
+}
+
+func (f Formatted[P]) MarshalJSON() ([]byte, error) {
+	return datetime.MarshalJSONText(f)
+}
+
+func (f *Formatted[P]) UnmarshalJSON(data []byte) error {
+	return datetime.UnmarshalJSONText(f, data)
+}
+
+func (f Formatted[P]) MarshalYAML() (any, error) {
+	return datetime.MarshalYAMLText(f)
+}
+
+func (f *Formatted[P]) UnmarshalYAML(node *yaml.Node) error {
+	return datetime.UnmarshalYAMLText(f, node)
+}
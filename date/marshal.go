@@ -0,0 +1,139 @@
+package date
+
+import (
+	"encoding/binary"
+	"fmt"
+	"github.com/gouef/datetime"
+)
+
+func (d Date) MarshalText() ([]byte, error) {
+	return []byte(d.ToString()), nil
+}
+
+func (d *Date) UnmarshalText(text []byte) error {
+	parsed, err := FromString(string(text))
+
+	if err != nil {
+		return err
+	}
+
+	*d = *parsed.(*Date)
+
+	return nil
+}
+
+// MarshalTextPattern returns d formatted by pattern, see Formatted for fields marshaled by pattern
+func (d Date) MarshalTextPattern(pattern datetime.Pattern) ([]byte, error) {
+	return []byte(d.Format(pattern)), nil
+}
+
+// UnmarshalTextPattern parses text by pattern into d
+func (d *Date) UnmarshalTextPattern(pattern datetime.Pattern, text []byte) error {
+	parsed, err := Parse(pattern, string(text))
+
+	if err != nil {
+		return err
+	}
+
+	*d = *parsed.(*Date)
+
+	return nil
+}
+
+func (d Date) MarshalJSON() ([]byte, error) {
+	return datetime.MarshalJSONText(d)
+}
+
+func (d *Date) UnmarshalJSON(data []byte) error {
+	return datetime.UnmarshalJSONText(d, data)
+}
+
+func (d Date) MarshalBinary() ([]byte, error) {
+	data := make([]byte, 7)
+	data[0] = 1
+	binary.BigEndian.PutUint32(data[1:], uint32(int32(d.Year())))
+	data[5] = byte(d.Month())
+	data[6] = byte(d.Day())
+
+	return data, nil
+}
+
+func (d *Date) UnmarshalBinary(data []byte) error {
+	if len(data) != 7 || data[0] != 1 {
+		return fmt.Errorf("%w of date", datetime.ErrInvalidBinary)
+	}
+
+	parsed, err := New(int(int32(binary.BigEndian.Uint32(data[1:]))), int(data[5]), int(data[6]))
+
+	if err != nil {
+		return err
+	}
+
+	*d = *parsed.(*Date)
+
+	return nil
+}
+
+func (d Value) MarshalText() ([]byte, error) {
+	return []byte(d), nil
+}
+
+func (d *Value) UnmarshalText(text []byte) error {
+	value, err := StringToValue(string(text))
+
+	if err != nil {
+		return err
+	}
+
+	*d = value
+
+	return nil
+}
+
+func (d Value) MarshalJSON() ([]byte, error) {
+	return datetime.MarshalJSONText(d)
+}
+
+func (d *Value) UnmarshalJSON(data []byte) error {
+	return datetime.UnmarshalJSONText(d, data)
+}
+
+func (d Value) MarshalBinary() ([]byte, error) {
+	return d.MarshalText()
+}
+
+func (d *Value) UnmarshalBinary(data []byte) error {
+	return d.UnmarshalText(data)
+}
+
+func (d Range) MarshalText() ([]byte, error) {
+	return []byte(d.String()), nil
+}
+
+func (d *Range) UnmarshalText(text []byte) error {
+	parsed, err := RangeFromString(string(text))
+
+	if err != nil {
+		return err
+	}
+
+	*d = *parsed
+
+	return nil
+}
+
+func (d Range) MarshalJSON() ([]byte, error) {
+	return datetime.MarshalJSONText(d)
+}
+
+func (d *Range) UnmarshalJSON(data []byte) error {
+	return datetime.UnmarshalJSONText(d, data)
+}
+
+func (d Range) MarshalBinary() ([]byte, error) {
+	return d.MarshalText()
+}
+
+func (d *Range) UnmarshalBinary(data []byte) error {
+	return d.UnmarshalText(data)
+}
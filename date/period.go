@@ -0,0 +1,90 @@
+package date
+
+import (
+	"github.com/gouef/datetime"
+	"time"
+)
+
+// StartOfDay returns date time of midnight of d
+func (d Date) StartOfDay() datetime.DateTime {
+	start, _ := datetime.New(d.Year(), d.Month(), d.Day(), 0, 0, 0)
+
+	return *start
+}
+
+// EndOfDay returns date time of the last nanosecond of d
+func (d Date) EndOfDay() datetime.DateTime {
+	end, _ := datetime.NewNano(d.Year(), d.Month(), d.Day(), 23, 59, 59, 999999999)
+
+	return *end
+}
+
+// StartOfWeek returns the first day of week of d, week starts on weekStart, e.g. time.Monday
+func (d Date) StartOfWeek(weekStart time.Weekday) Date {
+	return Date{dateTime: datetime.StartOfWeek(d.dateTime, weekStart)}
+}
+
+// EndOfWeek returns the last day of week of d, week starts on weekStart, e.g. time.Monday
+func (d Date) EndOfWeek(weekStart time.Weekday) Date {
+	return Date{dateTime: datetime.StartOfDay(datetime.EndOfWeek(d.dateTime, weekStart))}
+}
+
+func (d Date) StartOfMonth() Date {
+	return Date{dateTime: datetime.StartOfMonth(d.dateTime)}
+}
+
+func (d Date) EndOfMonth() Date {
+	return Date{dateTime: datetime.StartOfDay(datetime.EndOfMonth(d.dateTime))}
+}
+
+func (d Date) StartOfQuarter() Date {
+	return Date{dateTime: datetime.StartOfQuarter(d.dateTime)}
+}
+
+func (d Date) EndOfQuarter() Date {
+	return Date{dateTime: datetime.StartOfDay(datetime.EndOfQuarter(d.dateTime))}
+}
+
+func (d Date) StartOfYear() Date {
+	return Date{dateTime: datetime.StartOfYear(d.dateTime)}
+}
+
+func (d Date) EndOfYear() Date {
+	return Date{dateTime: datetime.StartOfDay(datetime.EndOfYear(d.dateTime))}
+}
+
+// DayRange returns date time range "[start, end]" of d
+func (d Date) DayRange() *datetime.Range {
+	r, _ := datetime.NewRangeStrict(d.StartOfDay().ToString(), d.EndOfDay().ToString())
+
+	return r
+}
+
+// WeekRange returns range "[first day, last day]" of week of d, week starts on weekStart
+func (d Date) WeekRange(weekStart time.Weekday) *Range {
+	return periodRange(d.StartOfWeek(weekStart), d.EndOfWeek(weekStart))
+}
+
+// MonthRange returns range "[first day, last day]" of month of d
+func (d Date) MonthRange() *Range {
+	return periodRange(d.StartOfMonth(), d.EndOfMonth())
+}
+
+// QuarterRange returns range "[first day, last day]" of quarter of d
+func (d Date) QuarterRange() *Range {
+	return periodRange(d.StartOfQuarter(), d.EndOfQuarter())
+}
+
+// YearRange returns range "[first day, last day]" of year of d
+func (d Date) YearRange() *Range {
+	return periodRange(d.StartOfYear(), d.EndOfYear())
+}
+
+func periodRange(start, end Date) *Range {
+	return &Range{
+		from:  Value(start.ToString()),
+		to:    Value(end.ToString()),
+		start: datetime.RangeStartStrict,
+		end:   datetime.RangeEndStrict,
+	}
+}
@@ -1,11 +1,8 @@
 package date
 
 import (
-	"errors"
 	"fmt"
 	"github.com/gouef/datetime"
-	"github.com/gouef/validator"
-	"github.com/gouef/validator/constraints"
 	"regexp"
 	"time"
 )
@@ -24,20 +21,20 @@ type Range struct {
 
 func NewRange(from, to string, start datetime.RangeStart, end datetime.RangeEnd) (*Range, error) {
 
-	_, err := FromString(from)
+	_, err := ParseString(from)
 
 	if from != "" && err != nil {
 		return nil, err
 	}
 
-	_, err = FromString(to)
+	_, err = ParseString(to)
 
 	if to != "" && err != nil {
 		return nil, err
 	}
 
 	if to == "" && from == "" {
-		return nil, errors.New("from and to can not be both empty")
+		return nil, datetime.ErrEmptyRange
 	}
 
 	return &Range{
@@ -64,15 +61,15 @@ func NewRangeStartOptional(from, to string) (*Range, error) {
 	return NewRange(from, to, datetime.RangeStartOptional, datetime.RangeEndStrict)
 }
 
+var rangeRegexp = regexp.MustCompile(RangeRegexp)
+
 func RangeFromString(dateRange string) (*Range, error) {
-	errs := validator.Validate(dateRange, constraints.RegularExpression{Regexp: RangeRegexp})
+	match := rangeRegexp.FindStringSubmatch(dateRange)
 
-	if len(errs) != 0 {
-		return nil, errors.New(fmt.Sprintf("unsupported format of date range \"%s\"", dateRange))
+	if match == nil {
+		return nil, &datetime.ParseError{Input: dateRange, Expected: "date range \"[2006-01-02, 2006-01-02]\""}
 	}
 
-	re := regexp.MustCompile(RangeRegexp)
-	match := re.FindStringSubmatch(dateRange)
 	openBracket, date1, date2, closeBracket := match[1], match[2], match[6], match[10]
 
 	return NewRange(date1, date2, datetime.RangeStart(openBracket), datetime.RangeEnd(closeBracket))
@@ -125,9 +122,17 @@ func (d *Range) format(date any) (datetime.Interface, error) {
 		return New(i.Year(), int(i.Month()), i.Day())
 	case *Date:
 		return i, nil
+	case Date:
+		return &i, nil
+	case datetime.DateTime:
+		d := FromDateTime(i)
+		return &d, nil
+	case *datetime.DateTime:
+		d := FromDateTime(*i)
+		return &d, nil
 	case string:
 		return FromString(i)
 	default:
-		return nil, errors.New("unsupported format of date")
+		return nil, fmt.Errorf("%w %T of date", datetime.ErrUnsupportedType, date)
 	}
 }
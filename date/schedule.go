@@ -0,0 +1,219 @@
+package date
+
+import (
+	"errors"
+	"fmt"
+	"github.com/gouef/datetime"
+	"math"
+)
+
+// ErrInvalidSchedule schedule end is not after its start
+var ErrInvalidSchedule = errors.New("invalid schedule")
+
+// Roll business day convention moving dates which are not business days
+type Roll int
+
+const (
+	// RollNone date is not adjusted
+	RollNone Roll = iota
+	// RollFollowing the first business day on or after date
+	RollFollowing
+	// RollModifiedFollowing like RollFollowing, but RollPreceding when it would move to the next month
+	RollModifiedFollowing
+	// RollPreceding the last business day on or before date
+	RollPreceding
+	// RollModifiedPreceding like RollPreceding, but RollFollowing when it would move to the previous month
+	RollModifiedPreceding
+	// RollEndOfMonth the last business day of month of date
+	RollEndOfMonth
+)
+
+var rollNames = map[Roll]string{
+	RollNone:              "None",
+	RollFollowing:         "Following",
+	RollModifiedFollowing: "Modified Following",
+	RollPreceding:         "Preceding",
+	RollModifiedPreceding: "Modified Preceding",
+	RollEndOfMonth:        "End of Month",
+}
+
+func (r Roll) String() string {
+	if name, ok := rollNames[r]; ok {
+		return name
+	}
+
+	return fmt.Sprintf("Roll(%d)", int(r))
+}
+
+// Adjust moves d to business day of calendar by roll, nil calendar means WeekendCalendar,
+// it returns datetime.ErrNoBusinessDay when calendar has no business day near d
+func (d Date) Adjust(roll Roll, calendar datetime.BusinessCalendar) (Date, error) {
+	calendar = businessCalendar(calendar)
+
+	switch roll {
+	case RollFollowing:
+		return d.roll(1, calendar)
+	case RollModifiedFollowing:
+		if following, err := d.roll(1, calendar); err != nil || following.Month() == d.Month() {
+			return following, err
+		}
+
+		return d.roll(-1, calendar)
+	case RollPreceding:
+		return d.roll(-1, calendar)
+	case RollModifiedPreceding:
+		if preceding, err := d.roll(-1, calendar); err != nil || preceding.Month() == d.Month() {
+			return preceding, err
+		}
+
+		return d.roll(1, calendar)
+	case RollEndOfMonth:
+		return LastBusinessDayOfMonth(d.Year(), d.Month(), calendar)
+	default:
+		return d, nil
+	}
+}
+
+// AddBusinessDays adds n (can be negative) business days of calendar to d, nil calendar means WeekendCalendar,
+// see datetime.AddBusinessDays
+func (d Date) AddBusinessDays(n int, calendar datetime.BusinessCalendar) (Date, error) {
+	t, err := datetime.AddBusinessDays(d.dateTime, n, businessCalendar(calendar))
+	return Date{dateTime: t}, err
+}
+
+// Settlement returns settlement date T+days of trade date d, trade on day which is not business day
+// is settled as traded on the following business day
+func (d Date) Settlement(days int, calendar datetime.BusinessCalendar) (Date, error) {
+	trade, err := d.Adjust(RollFollowing, calendar)
+
+	if err != nil {
+		return Date{}, err
+	}
+
+	return trade.AddBusinessDays(days, calendar)
+}
+
+// roll moves d by step days until it is business day
+func (d Date) roll(step int, calendar datetime.BusinessCalendar) (Date, error) {
+	if calendar.IsBusinessDay(d.dateTime) {
+		return d, nil
+	}
+
+	return d.AddBusinessDays(step, calendar)
+}
+
+func businessCalendar(calendar datetime.BusinessCalendar) datetime.BusinessCalendar {
+	if calendar == nil {
+		return datetime.WeekendCalendar()
+	}
+
+	return calendar
+}
+
+// Stub where irregular period of schedule is placed
+type Stub int
+
+const (
+	// StubShortInitial dates are generated backward from end, first period is shorter
+	StubShortInitial Stub = iota
+	// StubLongInitial like StubShortInitial, but short first period is merged with the second one
+	StubLongInitial
+	// StubShortFinal dates are generated forward from start, last period is shorter
+	StubShortFinal
+	// StubLongFinal like StubShortFinal, but short last period is merged with the previous one
+	StubLongFinal
+)
+
+// Schedule payment dates every Months months between Start and End
+type Schedule struct {
+	Start  Date
+	End    Date
+	Months int
+	// Anchor date which regular dates are Months apart from, zero means End for initial stubs and Start for final stubs
+	Anchor Date
+	Stub   Stub
+	// EndOfMonth keeps regular dates on the last day of month when Anchor is the last day of month
+	EndOfMonth bool
+	Roll       Roll
+	// Calendar of Roll, nil means WeekendCalendar
+	Calendar datetime.BusinessCalendar
+}
+
+// ScheduleDate date of schedule before and after adjustment by Roll
+type ScheduleDate struct {
+	Unadjusted Date
+	Adjusted   Date
+}
+
+// Dates returns dates of schedule from Start to End including both, periods between dates which are not Months long are stubs
+func (s Schedule) Dates() ([]ScheduleDate, error) {
+	if s.Months <= 0 {
+		return nil, &datetime.FieldRangeError{Field: "months", Value: s.Months, Min: 1, Max: math.MaxInt}
+	}
+
+	if !s.End.After(s.Start) {
+		return nil, fmt.Errorf("%w: end %s is not after start %s", ErrInvalidSchedule, s.End.ToString(), s.Start.ToString())
+	}
+
+	anchor := s.Anchor
+
+	if anchor.IsZero() {
+		anchor = s.End
+
+		if s.Stub == StubShortFinal || s.Stub == StubLongFinal {
+			anchor = s.Start
+		}
+	}
+
+	// the first regular date after Start
+	n := (s.Start.Year()-anchor.Year())*12 + s.Start.Month() - anchor.Month()
+	n = n/s.Months - 1
+
+	for !s.regular(anchor, n).After(s.Start) {
+		n++
+	}
+
+	first := n
+	unadjusted := []Date{s.Start}
+
+	for d := s.regular(anchor, n); d.Before(s.End); d = s.regular(anchor, n) {
+		unadjusted = append(unadjusted, d)
+		n++
+	}
+
+	unadjusted = append(unadjusted, s.End)
+
+	// stub is merged only when there is a regular period to merge it with
+	switch last := len(unadjusted) - 1; {
+	case last < 2:
+	case s.Stub == StubLongInitial && !s.regular(anchor, first-1).Equal(s.Start):
+		unadjusted = append(unadjusted[:1], unadjusted[2:]...)
+	case s.Stub == StubLongFinal && !s.regular(anchor, n).Equal(s.End):
+		unadjusted = append(unadjusted[:last-1], unadjusted[last])
+	}
+
+	dates := make([]ScheduleDate, len(unadjusted))
+
+	for i, d := range unadjusted {
+		adjusted, err := d.Adjust(s.Roll, s.Calendar)
+
+		if err != nil {
+			return nil, err
+		}
+
+		dates[i] = ScheduleDate{Unadjusted: d, Adjusted: adjusted}
+	}
+
+	return dates, nil
+}
+
+// regular returns regular date n periods from anchor
+func (s Schedule) regular(anchor Date, n int) Date {
+	d := addMonths(anchor, n*s.Months, LeapDayFebruary28)
+
+	if s.EndOfMonth && isLastOfMonth(anchor) {
+		return d.EndOfMonth()
+	}
+
+	return d
+}
@@ -0,0 +1,113 @@
+package date
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"github.com/gouef/datetime"
+	"time"
+)
+
+// Scan implements sql.Scanner, accepts time.Time, []byte and string
+func (d *Date) Scan(value any) error {
+	var parsed datetime.Interface
+	var err error
+
+	switch v := value.(type) {
+	case time.Time:
+		parsed, err = New(v.Year(), int(v.Month()), v.Day())
+	case []byte:
+		parsed, err = scanString(string(v))
+	case string:
+		parsed, err = scanString(v)
+	case nil:
+		return fmt.Errorf("%w into Date, use NullDate", datetime.ErrNull)
+	default:
+		return fmt.Errorf("%w %T scanned into Date", datetime.ErrUnsupportedType, value)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	*d = *parsed.(*Date)
+
+	return nil
+}
+
+func scanString(value string) (datetime.Interface, error) {
+	d, err := FromString(value)
+
+	if err == nil {
+		return d, nil
+	}
+
+	t, rfcErr := time.Parse(time.RFC3339, value)
+
+	if rfcErr != nil {
+		return nil, err
+	}
+
+	return New(t.Year(), int(t.Month()), t.Day())
+}
+
+// Value implements driver.Valuer
+func (d Date) Value() (driver.Value, error) {
+	return d.ToString(), nil
+}
+
+// NullDate Date which may be NULL
+type NullDate struct {
+	Date  Date
+	Valid bool
+}
+
+// Scan implements sql.Scanner
+func (n *NullDate) Scan(value any) error {
+	if value == nil {
+		n.Date, n.Valid = Date{}, false
+		return nil
+	}
+
+	n.Valid = true
+
+	return n.Date.Scan(value)
+}
+
+// Value implements driver.Valuer
+func (n NullDate) Value() (driver.Value, error) {
+	if !n.Valid {
+		return nil, nil
+	}
+
+	return n.Date.Value()
+}
+
+// Scan implements sql.Scanner, accepts PostgreSQL daterange literal
+func (d *Range) Scan(value any) error {
+	literal, err := datetime.ScanRangeLiteral(value)
+
+	if err != nil {
+		return err
+	}
+
+	start, from, to, end, err := datetime.ParseRangeLiteral(literal)
+
+	if err != nil {
+		return err
+	}
+
+	parsed, err := NewRange(from, to, start, end)
+
+	if err != nil {
+		return err
+	}
+
+	*d = *parsed
+
+	return nil
+}
+
+// Value implements driver.Valuer, returns PostgreSQL daterange literal
+func (d Range) Value() (driver.Value, error) {
+	return datetime.FormatRangeLiteral(d.start, string(d.from), string(d.to), d.end), nil
+}
@@ -1,27 +1,25 @@
 package date
 
 import (
-	"errors"
-	"fmt"
 	"github.com/gouef/datetime"
-	"github.com/gouef/validator"
-	"github.com/gouef/validator/constraints"
+	"time"
 )
 
 type Value string
 
 func StringToValue(value string) (Value, error) {
-	errs := validator.Validate(value, constraints.RegularExpression{Regexp: DateTimeRegexp})
+	d, err := ParseString(value)
 
-	d, err := FromString(value)
-
-	if len(errs) != 0 || err != nil {
-		return "", errors.New(fmt.Sprintf("unsupported format of date \"%s\"", value))
+	if err != nil {
+		return "", err
 	}
 
-	str := d.ToString()
+	// value is already in canonical format unless time follows the date
+	if len(value) == len(time.DateOnly) {
+		return Value(value), nil
+	}
 
-	return Value(str), nil
+	return Value(d.ToString()), nil
 }
 
 func (d Value) Date() datetime.Interface {
@@ -0,0 +1,92 @@
+package date
+
+import (
+	"github.com/gouef/datetime"
+	"time"
+)
+
+// NthWeekdayOfMonth returns n-th weekday of month, negative n counts from the end of month, see datetime.NthWeekdayOfMonth
+func NthWeekdayOfMonth(year, month int, weekday time.Weekday, n int) (Date, error) {
+	t, err := datetime.NthWeekdayOfMonth(year, month, weekday, n)
+
+	if err != nil {
+		return Date{}, err
+	}
+
+	return Date{dateTime: t}, nil
+}
+
+// NextWeekday returns the first weekday after d
+func (d Date) NextWeekday(weekday time.Weekday) Date {
+	return Date{dateTime: datetime.NextWeekday(d.dateTime, weekday)}
+}
+
+// PreviousWeekday returns the last weekday before d
+func (d Date) PreviousWeekday(weekday time.Weekday) Date {
+	return Date{dateTime: datetime.PreviousWeekday(d.dateTime, weekday)}
+}
+
+// LastBusinessDayOfMonth returns the last business day of month, nil calendar means WeekendCalendar,
+// see datetime.LastBusinessDayOfMonth
+func LastBusinessDayOfMonth(year, month int, calendar datetime.BusinessCalendar) (Date, error) {
+	t, err := datetime.LastBusinessDayOfMonth(year, month, businessCalendar(calendar))
+	return Date{dateTime: t}, err
+}
+
+// CountWeekdays returns number of days of range which are one of weekdays, e.g. Saturday and Sunday.
+// Brackets of range are ignored and both from and to are counted like in Windows, unlike by Range.Is which excludes them.
+// Range without from returns ErrUnboundedRange and range without to returns ErrRangeWithoutTo
+func (d *Range) CountWeekdays(weekdays ...time.Weekday) (int, error) {
+	if d.From() == "" {
+		return 0, ErrUnboundedRange
+	}
+
+	if d.To() == "" {
+		return 0, ErrRangeWithoutTo
+	}
+
+	from, err := ParseString(string(d.From()))
+
+	if err != nil {
+		return 0, err
+	}
+
+	to, err := ParseString(string(d.To()))
+
+	if err != nil {
+		return 0, err
+	}
+
+	days := DaysBetween(from, to) + 1
+
+	if days <= 0 {
+		return 0, nil
+	}
+
+	count := 0
+
+	for _, weekday := range uniqueWeekdays(weekdays) {
+		// full weeks contain every weekday once, the rest starts on weekday of from
+		count += days / 7
+
+		if (int(weekday)-int(from.Weekday())+7)%7 < days%7 {
+			count++
+		}
+	}
+
+	return count, nil
+}
+
+func uniqueWeekdays(weekdays []time.Weekday) []time.Weekday {
+	seen := [7]bool{}
+	unique := make([]time.Weekday, 0, len(weekdays))
+
+	for _, weekday := range weekdays {
+		if weekday >= time.Sunday && weekday <= time.Saturday && !seen[weekday] {
+			seen[weekday] = true
+			unique = append(unique, weekday)
+		}
+	}
+
+	return unique
+}
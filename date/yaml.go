@@ -0,0 +1,30 @@
+package date
+
+import (
+	"github.com/gouef/datetime"
+	"gopkg.in/yaml.v3"
+)
+
+func (d Date) MarshalYAML() (any, error) {
+	return datetime.MarshalYAMLText(d)
+}
+
+func (d *Date) UnmarshalYAML(node *yaml.Node) error {
+	return datetime.UnmarshalYAMLText(d, node)
+}
+
+func (d Value) MarshalYAML() (any, error) {
+	return datetime.MarshalYAMLText(d)
+}
+
+func (d *Value) UnmarshalYAML(node *yaml.Node) error {
+	return datetime.UnmarshalYAMLText(d, node)
+}
+
+func (d Range) MarshalYAML() (any, error) {
+	return datetime.MarshalYAMLText(d)
+}
+
+func (d *Range) UnmarshalYAML(node *yaml.Node) error {
+	return datetime.UnmarshalYAMLRange(d, node)
+}
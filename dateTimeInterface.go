@@ -2,6 +2,9 @@ package datetime
 
 import "time"
 
+// Interface of DateTime, date.Date and time.Time. Compare, Equal, Between, Before and After compare instants
+// of Time(), where date is at midnight UTC and time of day is on January 1 of year 0,
+// use CompareAt to compare values of different types
 type Interface interface {
 	ToString() string
 	FromString(value string) (Interface, error)
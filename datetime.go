@@ -1,151 +1,268 @@
 package datetime
 
 import (
-	"errors"
-	"fmt"
+	"context"
+	"github.com/gouef/datetime/internal/scan"
 	"github.com/gouef/utils"
-	"github.com/gouef/validator"
-	"github.com/gouef/validator/constraints"
-	"regexp"
-	"strconv"
+	"math"
 	"time"
 )
 
 const (
-	Regexp = `^((\d+)-(0[1-9]|1[0-2])-(0[1-9]|[12][0-9]|3[01]))\s(0[0-9]|1[0-9]|2[0-3]):([0-5][0-9]):([0-5][0-9])$`
+	Regexp = `^((\d+)-(0[1-9]|1[0-2])-(0[1-9]|[12][0-9]|3[01]))\s(0[0-9]|1[0-9]|2[0-3]):([0-5][0-9]):([0-5][0-9])(\.\d{1,9})?$`
 )
 
+// DateTime date and time of day without time zone, it is immutable and safe to share between goroutines.
+// Create it by New, FromString or Now, zero value is "0001-01-01 00:00:00", see IsZero
 type DateTime struct {
-	Year     int
-	Month    int `validate:"min=1,max=12"`
-	Day      int `validate:"min=1,max=31"`
-	Hour     int `validate:"min=0,max=23"`
-	Minute   int `validate:"min=0,max=59"`
-	Second   int `validate:"min=0,max=59"`
-	DateTime time.Time
+	dateTime time.Time
 }
 
+// Now current date time of clock set by SetClock
 func Now() *DateTime {
-	now := time.Now()
+	return NowContext(context.Background())
+}
+
+// NowContext current date time of clock carried by ctx, see WithClock
+func NowContext(ctx context.Context) *DateTime {
+	now := ClockFromContext(ctx).Now()
 
+	// wall clock of now, DateTime does not keep location
 	return &DateTime{
-		Year:     now.Year(),
-		Month:    int(now.Month()),
-		Day:      now.Day(),
-		Hour:     now.Hour(),
-		Minute:   now.Minute(),
-		Second:   now.Second(),
-		DateTime: now,
+		dateTime: time.Date(now.Year(), now.Month(), now.Day(), now.Hour(), now.Minute(), now.Second(), now.Nanosecond(), time.UTC),
 	}
 }
 
 func New(year, month, day, hour, minute, second int) (*DateTime, error) {
-	errs := validator.Validate(year, constraints.GreaterOrEqual{Value: 0})
+	return NewNano(year, month, day, hour, minute, second, 0)
+}
 
-	if len(errs) > 0 {
-		return nil, errors.New(fmt.Sprintf("year must be 0 or greater get \"%d\"", year))
+// NewNano like New with fraction of second in nanoseconds 0-999999999
+func NewNano(year, month, day, hour, minute, second, nanosecond int) (*DateTime, error) {
+	d, err := newDateTime(year, month, day, hour, minute, second, nanosecond)
+
+	if err != nil {
+		return nil, err
 	}
 
-	errs = validator.Validate(month, constraints.Range{Min: 1, Max: 12})
+	return &d, nil
+}
 
-	if len(errs) > 0 {
-		return nil, errors.New(fmt.Sprintf("month must be between 1-12 get \"%d\"", month))
-	}
-	daysInMonth := DaysInMonth(year, month)
-	errs = validator.Validate(day, constraints.Range{Min: 1, Max: float64(daysInMonth)})
+// Validate checks ranges of fields including days in month. It is kept for struct validation by validators,
+// values of constructors are always valid and only arithmetic can move them out of range, e.g. Truncate in year 0
+func (d DateTime) Validate() error {
+	_, err := newDateTime(d.Year(), d.Month(), d.Day(), d.Hour(), d.Minute(), d.Second(), d.Nanosecond())
+	return err
+}
 
-	if len(errs) > 0 {
-		return nil, errors.New(fmt.Sprintf("day must be between 1-%d for month %d of year %d get \"%d\"", daysInMonth, month, year, day))
+// newDateTime validates fields and returns DateTime by value, so that ParseString does not allocate
+func newDateTime(year, month, day, hour, minute, second, nanosecond int) (DateTime, error) {
+	if year < 0 {
+		return DateTime{}, &FieldRangeError{Field: "year", Value: year, Min: 0, Max: math.MaxInt}
 	}
 
-	errs = validator.Validate(hour, constraints.Range{Min: 0, Max: 23})
+	if err := CheckField("month", month, 1, 12); err != nil {
+		return DateTime{}, err
+	}
 
-	if len(errs) > 0 {
-		return nil, errors.New(fmt.Sprintf("hour must be between 0-23 get \"%d\"", hour))
+	if err := CheckField("day", day, 1, DaysInMonth(year, month)); err != nil {
+		return DateTime{}, err
 	}
 
-	errs = validator.Validate(minute, constraints.Range{Min: 0, Max: 59})
+	if err := CheckField("hour", hour, 0, 23); err != nil {
+		return DateTime{}, err
+	}
 
-	if len(errs) > 0 {
-		return nil, errors.New(fmt.Sprintf("minute must be between 0-59 get \"%d\"", minute))
+	if err := CheckField("minute", minute, 0, 59); err != nil {
+		return DateTime{}, err
 	}
 
-	errs = validator.Validate(second, constraints.Range{Min: 0, Max: 59})
+	if err := CheckField("second", second, 0, 59); err != nil {
+		return DateTime{}, err
+	}
 
-	if len(errs) > 0 {
-		return nil, errors.New(fmt.Sprintf("second must be between 0-59 get \"%d\"", second))
+	if err := CheckField("nanosecond", nanosecond, 0, 999999999); err != nil {
+		return DateTime{}, err
 	}
 
-	return &DateTime{
-		Year:     year,
-		Month:    month,
-		Day:      day,
-		Hour:     hour,
-		Minute:   minute,
-		Second:   second,
-		DateTime: time.Date(year, time.Month(month), day, hour, minute, second, 0, time.UTC),
-	}, nil
+	return DateTime{dateTime: time.Date(year, time.Month(month), day, hour, minute, second, nanosecond, time.UTC)}, nil
+}
+
+// fromTime returns DateTime of wall clock of t
+func fromTime(t time.Time) (*DateTime, error) {
+	return NewNano(t.Year(), int(t.Month()), t.Day(), t.Hour(), t.Minute(), t.Second(), t.Nanosecond())
 }
 
 func FromString(value string) (Interface, error) {
-	errs := validator.Validate(value, constraints.RegularExpression{Regexp: Regexp})
+	d, err := ParseString(value)
 
-	if len(errs) != 0 {
-		return nil, errors.New(fmt.Sprintf("unsupported format of date \"%s\"", value))
+	if err != nil {
+		return nil, err
 	}
 
-	re := regexp.MustCompile(Regexp)
-	match := re.FindStringSubmatch(value)
-	year, _ := strconv.Atoi(match[2])
-	month, _ := strconv.Atoi(match[3])
-	day, _ := strconv.Atoi(match[4])
-	hour, _ := strconv.Atoi(match[5])
-	minute, _ := strconv.Atoi(match[6])
-	second, _ := strconv.Atoi(match[7])
+	return &d, nil
+}
+
+// ParseString parses "2006-01-02 15:04:05" with optional fraction of second ".999999999" like FromString, but returns DateTime by value
+// and does not allocate unless value is invalid
+func ParseString(value string) (DateTime, error) {
+	i := 0
+	year := 0
+
+	for ; i < len(value) && scan.IsDigit(value[i]); i++ {
+		if year > (math.MaxInt-9)/10 {
+			return DateTime{}, parseFailed(value, 0, "year")
+		}
+
+		year = year*10 + int(value[i]-'0')
+	}
+
+	if i == 0 {
+		return DateTime{}, parseFailed(value, 0, "year")
+	}
+
+	month, i, err := scanField(value, i, '-', 1, 12, "month 01-12")
+
+	if err != nil {
+		return DateTime{}, err
+	}
+
+	day, i, err := scanField(value, i, '-', 1, 31, "day 01-31")
+
+	if err != nil {
+		return DateTime{}, err
+	}
+
+	if i >= len(value) || !isSpace(value[i]) {
+		return DateTime{}, parseFailed(value, i, "space")
+	}
+
+	hour, ok := scan.Digits(value, i+1, 2)
+
+	if !ok || hour > 23 {
+		return DateTime{}, parseFailed(value, i+1, "hour 00-23")
+	}
+
+	i += 3
+
+	minute, i, err := scanField(value, i, ':', 0, 59, "minute 00-59")
+
+	if err != nil {
+		return DateTime{}, err
+	}
 
-	return New(year, month, day, hour, minute, second)
+	second, i, err := scanField(value, i, ':', 0, 59, "second 00-59")
+
+	if err != nil {
+		return DateTime{}, err
+	}
+
+	nanosecond, i, ok := scan.Fraction(value, i)
+
+	if !ok {
+		return DateTime{}, parseFailed(value, i+1, "digits of fraction")
+	}
+
+	if i != len(value) {
+		return DateTime{}, parseFailed(value, i, "end of value")
+	}
+
+	return newDateTime(year, month, day, hour, minute, second, nanosecond)
 }
 
-func (d *DateTime) FromString(value string) (Interface, error) {
+func (d DateTime) FromString(value string) (Interface, error) {
 	return FromString(value)
 }
 
-func (d *DateTime) ToString() string {
-	return d.Time().Format(time.DateTime)
+// ToString returns "2006-01-02 15:04:05", fraction of second is added without trailing zeros when it is not zero
+func (d DateTime) ToString() string {
+	return d.Time().Format(time.DateTime + ".999999999")
+}
+
+// ToStringPrecision returns "2006-01-02 15:04:05" with fraction of second of precision digits
+func (d DateTime) ToStringPrecision(precision Precision) string {
+	return d.Time().Format(time.DateTime + precision.Layout())
 }
 
 func GetDate(year, month, day int) time.Time {
 	return time.Date(year, time.Month(month), day, 0, 0, 0, 0, time.UTC)
 }
 
-func (d *DateTime) IsWeekend() bool {
+func (d DateTime) Year() int {
+	return d.dateTime.Year()
+}
+
+func (d DateTime) Month() int {
+	return int(d.dateTime.Month())
+}
+
+func (d DateTime) Day() int {
+	return d.dateTime.Day()
+}
+
+func (d DateTime) Hour() int {
+	return d.dateTime.Hour()
+}
+
+func (d DateTime) Minute() int {
+	return d.dateTime.Minute()
+}
+
+func (d DateTime) Second() int {
+	return d.dateTime.Second()
+}
+
+func (d DateTime) Nanosecond() int {
+	return d.dateTime.Nanosecond()
+}
+
+func (d DateTime) Weekday() time.Weekday {
+	return d.dateTime.Weekday()
+}
+
+// IsZero reports whether d is zero value, "0001-01-01 00:00:00"
+func (d DateTime) IsZero() bool {
+	return d.dateTime.IsZero()
+}
+
+func (d DateTime) IsWeekend() bool {
 	weekendDays := []time.Weekday{time.Sunday, time.Saturday}
-	return utils.InArray(d.DateTime.Weekday(), weekendDays)
+	return utils.InArray(d.Weekday(), weekendDays)
+}
+
+func (d DateTime) Time() time.Time {
+	return d.dateTime
 }
 
-func (d *DateTime) Time() time.Time {
-	return time.Date(d.Year, time.Month(d.Month), d.Day, d.Hour, d.Minute, d.Second, 0, time.UTC)
+// Truncate returns d rounded down to a multiple of unit counted from midnight, e.g. time.Millisecond, see FloorToStep
+func (d DateTime) Truncate(unit time.Duration) DateTime {
+	return d.FloorToStep(unit)
+}
+
+// Round returns d rounded to the nearest multiple of unit counted from midnight, halfway values are rounded up, see RoundToStep
+func (d DateTime) Round(unit time.Duration) DateTime {
+	return d.RoundToStep(unit)
 }
 
 // Compare compares the date instant d with u. If d is before u, it returns -1;
 // if d is after u, it returns +1; if they're the same, it returns 0.
-func (d *DateTime) Compare(u Interface) int {
+func (d DateTime) Compare(u Interface) int {
 	return d.Time().Compare(u.Time())
 }
 
-func (d *DateTime) Equal(u Interface) bool {
+func (d DateTime) Equal(u Interface) bool {
 	return d.Time().Equal(u.Time())
 }
 
-func (d *DateTime) Between(start, end Interface) bool {
+func (d DateTime) Between(start, end Interface) bool {
 	return d.Before(end) && d.After(start)
 }
 
-func (d *DateTime) Before(u Interface) bool {
+func (d DateTime) Before(u Interface) bool {
 	return d.Time().Before(u.Time())
 }
 
-func (d *DateTime) After(u Interface) bool {
+func (d DateTime) After(u Interface) bool {
 	return d.Time().After(u.Time())
 }
 
@@ -159,6 +276,18 @@ func DaysInMonthList(year int, month int) []int {
 }
 
 func DaysInMonth(year int, month int) int {
+	switch month {
+	case 2:
+		if year%4 == 0 && (year%100 != 0 || year%400 == 0) {
+			return 29
+		}
+		return 28
+	case 4, 6, 9, 11:
+		return 30
+	case 1, 3, 5, 7, 8, 10, 12:
+		return 31
+	}
+
 	return DaysInMonthByDate(GetDate(year, month, 1))
 }
 
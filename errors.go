@@ -0,0 +1,84 @@
+package datetime
+
+import (
+	"errors"
+	"fmt"
+	"math"
+)
+
+var (
+	// ErrOutOfRange matches every *FieldRangeError
+	ErrOutOfRange = errors.New("value out of range")
+	// ErrInvalidFormat matches every *ParseError
+	ErrInvalidFormat = errors.New("invalid format")
+	// ErrEmptyRange range without both from and to
+	ErrEmptyRange = errors.New("from and to of range can not be both empty")
+	// ErrNull NULL scanned into type which is not nullable
+	ErrNull = errors.New("can not scan NULL, use nullable type")
+	// ErrUnsupportedType value of unsupported type scanned
+	ErrUnsupportedType = errors.New("unsupported type")
+	// ErrInvalidBinary binary data of unknown version or length
+	ErrInvalidBinary = errors.New("invalid binary data")
+	// ErrIncomparable values can not be compared at granularity, see CompareAt
+	ErrIncomparable = errors.New("values are not comparable")
+	// ErrNoBusinessDay business calendar has no business day in searched days, e.g. all weekdays are weekend
+	ErrNoBusinessDay = errors.New("no business day")
+)
+
+// FieldRangeError field (year, month, day, hour, minute, second) is out of range Min-Max
+type FieldRangeError struct {
+	Field string
+	Value int
+	Min   int
+	// Max math.MaxInt when field has no upper bound
+	Max int
+}
+
+func (e *FieldRangeError) Error() string {
+	if e.Max == math.MaxInt {
+		return fmt.Sprintf("%s must be %d or greater get \"%d\"", e.Field, e.Min, e.Value)
+	}
+
+	return fmt.Sprintf("%s must be between %d-%d get \"%d\"", e.Field, e.Min, e.Max, e.Value)
+}
+
+func (e *FieldRangeError) Is(target error) bool {
+	return target == ErrOutOfRange
+}
+
+// ParseError input does not match expected format
+type ParseError struct {
+	Input string
+	// Position byte offset in Input where parsing failed, 0 when Input is rejected as a whole
+	Position int
+	Expected string
+	// Err cause, e.g. *FieldRangeError of parsed value
+	Err error
+}
+
+func (e *ParseError) Error() string {
+	message := fmt.Sprintf("unsupported format of \"%s\" at position %d, expected %s", e.Input, e.Position, e.Expected)
+
+	if e.Err != nil {
+		message += ": " + e.Err.Error()
+	}
+
+	return message
+}
+
+func (e *ParseError) Is(target error) bool {
+	return target == ErrInvalidFormat
+}
+
+func (e *ParseError) Unwrap() error {
+	return e.Err
+}
+
+// CheckField returns *FieldRangeError when value of field is not between min and max
+func CheckField(field string, value, min, max int) error {
+	if value < min || value > max {
+		return &FieldRangeError{Field: field, Value: value, Min: min, Max: max}
+	}
+
+	return nil
+}
@@ -0,0 +1,510 @@
+package datetime
+
+import (
+	"errors"
+	"fmt"
+	"github.com/gouef/datetime/locale"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// FormatTime formats t by pattern
+func FormatTime(t time.Time, pattern Pattern) string {
+	var b strings.Builder
+	l := pattern.locale()
+
+	for _, e := range compile(pattern) {
+		formatElement(&b, t, e, l)
+	}
+
+	return b.String()
+}
+
+func formatElement(b *strings.Builder, t time.Time, e element, l *locale.Locale) {
+	switch e.kind {
+	case elementLiteral:
+		b.WriteString(e.literal)
+	case elementYear:
+		writeNumber(b, t.Year(), 4, '0')
+	case elementYearShort:
+		writeNumber(b, t.Year()%100, 2, '0')
+	case elementISOYear:
+		year, _ := t.ISOWeek()
+		writeNumber(b, year, 4, '0')
+	case elementMonth:
+		writeNumber(b, int(t.Month()), 1, '0')
+	case elementMonthPadded:
+		writeNumber(b, int(t.Month()), 2, '0')
+	case elementMonthShortName:
+		b.WriteString(l.MonthName(t.Month(), locale.ContextFormat, locale.WidthAbbreviated))
+	case elementMonthName:
+		b.WriteString(l.MonthName(t.Month(), locale.ContextFormat, locale.WidthWide))
+	case elementMonthStandaloneShortName:
+		b.WriteString(l.MonthName(t.Month(), locale.ContextStandalone, locale.WidthAbbreviated))
+	case elementMonthStandaloneName:
+		b.WriteString(l.MonthName(t.Month(), locale.ContextStandalone, locale.WidthWide))
+	case elementDay:
+		writeNumber(b, t.Day(), 1, '0')
+	case elementDayPadded:
+		writeNumber(b, t.Day(), 2, '0')
+	case elementDaySpacePadded:
+		writeNumber(b, t.Day(), 2, ' ')
+	case elementDayOrdinalSuffix:
+		b.WriteString(ordinalSuffix(t.Day()))
+	case elementDayOfYear:
+		writeNumber(b, t.YearDay(), 1, '0')
+	case elementDayOfYearPadded:
+		writeNumber(b, t.YearDay(), 3, '0')
+	case elementDayOfYearZeroBased:
+		writeNumber(b, t.YearDay()-1, 1, '0')
+	case elementWeekdayShortName:
+		b.WriteString(l.WeekdayName(t.Weekday(), locale.ContextFormat, locale.WidthAbbreviated))
+	case elementWeekdayName:
+		b.WriteString(l.WeekdayName(t.Weekday(), locale.ContextFormat, locale.WidthWide))
+	case elementWeekdayStandaloneShortName:
+		b.WriteString(l.WeekdayName(t.Weekday(), locale.ContextStandalone, locale.WidthAbbreviated))
+	case elementWeekdayStandaloneName:
+		b.WriteString(l.WeekdayName(t.Weekday(), locale.ContextStandalone, locale.WidthWide))
+	case elementWeekdayISO:
+		weekday := int(t.Weekday())
+		if weekday == 0 {
+			weekday = 7
+		}
+		writeNumber(b, weekday, 1, '0')
+	case elementWeekdayNumber:
+		writeNumber(b, int(t.Weekday()), 1, '0')
+	case elementISOWeek:
+		_, week := t.ISOWeek()
+		writeNumber(b, week, 2, '0')
+	case elementDaysInMonth:
+		writeNumber(b, DaysInMonthByDate(t), 2, '0')
+	case elementLeapYear:
+		if DaysInMonth(t.Year(), 2) == 29 {
+			b.WriteByte('1')
+		} else {
+			b.WriteByte('0')
+		}
+	case elementHour:
+		writeNumber(b, t.Hour(), 1, '0')
+	case elementHourPadded:
+		writeNumber(b, t.Hour(), 2, '0')
+	case elementHourSpacePadded:
+		writeNumber(b, t.Hour(), 2, ' ')
+	case elementHour12:
+		writeNumber(b, hour12(t.Hour()), 1, '0')
+	case elementHour12Padded:
+		writeNumber(b, hour12(t.Hour()), 2, '0')
+	case elementHour12SpacePadded:
+		writeNumber(b, hour12(t.Hour()), 2, ' ')
+	case elementMinute:
+		writeNumber(b, t.Minute(), 1, '0')
+	case elementMinutePadded:
+		writeNumber(b, t.Minute(), 2, '0')
+	case elementSecond:
+		writeNumber(b, t.Second(), 1, '0')
+	case elementSecondPadded:
+		writeNumber(b, t.Second(), 2, '0')
+	case elementFraction:
+		writeNumber(b, t.Nanosecond()/pow10(9-e.width), e.width, '0')
+	case elementAmPm:
+		b.WriteString(l.AmPm[t.Hour()/12])
+	case elementAmPmLower:
+		b.WriteString(strings.ToLower(l.AmPm[t.Hour()/12]))
+	case elementZoneAbbreviation:
+		name, _ := t.Zone()
+		b.WriteString(name)
+	case elementZoneName:
+		b.WriteString(t.Location().String())
+	case elementOffset, elementOffsetColon, elementOffsetZ, elementOffsetColonZ:
+		writeOffset(b, t, e.kind)
+	case elementUnix:
+		b.WriteString(strconv.FormatInt(t.Unix(), 10))
+	}
+}
+
+func writeNumber(b *strings.Builder, value, width int, pad byte) {
+	if value < 0 {
+		b.WriteByte('-')
+		value = -value
+	}
+
+	digits := strconv.Itoa(value)
+
+	for i := len(digits); i < width; i++ {
+		b.WriteByte(pad)
+	}
+
+	b.WriteString(digits)
+}
+
+func writeOffset(b *strings.Builder, t time.Time, kind elementKind) {
+	_, offset := t.Zone()
+
+	if offset == 0 && (kind == elementOffsetZ || kind == elementOffsetColonZ) {
+		b.WriteByte('Z')
+		return
+	}
+
+	if offset < 0 {
+		b.WriteByte('-')
+		offset = -offset
+	} else {
+		b.WriteByte('+')
+	}
+
+	writeNumber(b, offset/3600, 2, '0')
+
+	if kind == elementOffsetColon || kind == elementOffsetColonZ {
+		b.WriteByte(':')
+	}
+
+	writeNumber(b, offset%3600/60, 2, '0')
+}
+
+func pow10(n int) int {
+	p := 1
+
+	for ; n > 0; n-- {
+		p *= 10
+	}
+
+	return p
+}
+
+func hour12(hour int) int {
+	if hour%12 == 0 {
+		return 12
+	}
+
+	return hour % 12
+}
+
+func ordinalSuffix(day int) string {
+	if day >= 11 && day <= 13 {
+		return "th"
+	}
+
+	switch day % 10 {
+	case 1:
+		return "st"
+	case 2:
+		return "nd"
+	case 3:
+		return "rd"
+	}
+
+	return "th"
+}
+
+type parsed struct {
+	year, month, day        int
+	yearDay                 int
+	hour, minute, second    int
+	nanosecond              int
+	pm, hasAmPm, hasUnix    bool
+	hasMonthDay, hasYearDay bool
+	unix                    int64
+	location                *time.Location
+}
+
+// ParseTime parses value by pattern, missing fields default to 0000-01-01 00:00:00 UTC
+func ParseTime(pattern Pattern, value string) (time.Time, error) {
+	p := parsed{month: 1, day: 1, location: time.UTC}
+	rest := value
+	l := pattern.locale()
+
+	for _, e := range compile(pattern) {
+		var err error
+		rest, err = parseElement(&p, e, rest, l)
+
+		if err != nil {
+			return time.Time{}, &ParseError{Input: value, Position: len(value) - len(rest), Expected: err.Error()}
+		}
+	}
+
+	if rest != "" {
+		return time.Time{}, &ParseError{Input: value, Position: len(value) - len(rest), Expected: "end of value"}
+	}
+
+	t, err := p.time()
+
+	if err != nil {
+		return time.Time{}, &ParseError{Input: value, Expected: fmt.Sprintf("valid date time of pattern \"%s\"", pattern.Layout), Err: err}
+	}
+
+	return t, nil
+}
+
+func (p *parsed) time() (time.Time, error) {
+	if p.hasUnix {
+		return time.Unix(p.unix, 0).In(p.location), nil
+	}
+
+	if p.hasYearDay && !p.hasMonthDay {
+		if p.yearDay < 1 || p.yearDay > time.Date(p.year, 12, 31, 0, 0, 0, 0, time.UTC).YearDay() {
+			return time.Time{}, &FieldRangeError{Field: "day of year", Value: p.yearDay, Min: 1, Max: time.Date(p.year, 12, 31, 0, 0, 0, 0, time.UTC).YearDay()}
+		}
+
+		t := time.Date(p.year, 1, p.yearDay, 0, 0, 0, 0, time.UTC)
+		p.month, p.day = int(t.Month()), t.Day()
+	}
+
+	if p.hasAmPm {
+		if p.hour < 1 || p.hour > 12 {
+			return time.Time{}, &FieldRangeError{Field: "hour", Value: p.hour, Min: 1, Max: 12}
+		}
+
+		p.hour %= 12
+
+		if p.pm {
+			p.hour += 12
+		}
+	}
+
+	switch {
+	case p.month < 1 || p.month > 12:
+		return time.Time{}, &FieldRangeError{Field: "month", Value: p.month, Min: 1, Max: 12}
+	case p.day < 1 || p.day > DaysInMonth(p.year, p.month):
+		return time.Time{}, &FieldRangeError{Field: "day", Value: p.day, Min: 1, Max: DaysInMonth(p.year, p.month)}
+	case p.hour > 23:
+		return time.Time{}, &FieldRangeError{Field: "hour", Value: p.hour, Min: 0, Max: 23}
+	case p.minute > 59:
+		return time.Time{}, &FieldRangeError{Field: "minute", Value: p.minute, Min: 0, Max: 59}
+	case p.second > 59:
+		return time.Time{}, &FieldRangeError{Field: "second", Value: p.second, Min: 0, Max: 59}
+	}
+
+	return time.Date(p.year, time.Month(p.month), p.day, p.hour, p.minute, p.second, p.nanosecond, p.location), nil
+}
+
+func parseElement(p *parsed, e element, value string, l *locale.Locale) (string, error) {
+	var err error
+
+	switch e.kind {
+	case elementLiteral:
+		if !strings.HasPrefix(value, e.literal) {
+			return value, fmt.Errorf("\"%s\"", e.literal)
+		}
+		return value[len(e.literal):], nil
+	case elementYear, elementISOYear:
+		p.year, value, err = parseNumber(value, 1, 4)
+	case elementYearShort:
+		p.year, value, err = parseNumber(value, 2, 2)
+		if p.year >= 69 {
+			p.year += 1900
+		} else {
+			p.year += 2000
+		}
+	case elementMonth:
+		p.month, value, err = parseNumber(value, 1, 2)
+		p.hasMonthDay = true
+	case elementMonthPadded:
+		p.month, value, err = parseNumber(value, 2, 2)
+		p.hasMonthDay = true
+	case elementMonthShortName, elementMonthName, elementMonthStandaloneShortName, elementMonthStandaloneName:
+		p.month, value, err = parseName(value, allNames(l.Months)...)
+		p.month++
+		p.hasMonthDay = true
+	case elementDay:
+		p.day, value, err = parseNumber(value, 1, 2)
+		p.hasMonthDay = true
+	case elementDayPadded:
+		p.day, value, err = parseNumber(value, 2, 2)
+		p.hasMonthDay = true
+	case elementDaySpacePadded:
+		p.day, value, err = parseNumber(strings.TrimPrefix(value, " "), 1, 2)
+		p.hasMonthDay = true
+	case elementDayOrdinalSuffix:
+		_, value, err = parseName(value, []string{"st", "nd", "rd", "th"})
+	case elementDayOfYear:
+		p.yearDay, value, err = parseNumber(value, 1, 3)
+		p.hasYearDay = true
+	case elementDayOfYearPadded:
+		p.yearDay, value, err = parseNumber(value, 3, 3)
+		p.hasYearDay = true
+	case elementDayOfYearZeroBased:
+		p.yearDay, value, err = parseNumber(value, 1, 3)
+		p.yearDay++
+		p.hasYearDay = true
+	case elementWeekdayShortName, elementWeekdayName, elementWeekdayStandaloneShortName, elementWeekdayStandaloneName:
+		_, value, err = parseName(value, allNames(l.Weekdays)...)
+	case elementWeekdayISO, elementWeekdayNumber, elementLeapYear:
+		_, value, err = parseNumber(value, 1, 1)
+	case elementISOWeek, elementDaysInMonth:
+		_, value, err = parseNumber(value, 2, 2)
+	case elementHour, elementHour12:
+		p.hour, value, err = parseNumber(value, 1, 2)
+	case elementHourPadded, elementHour12Padded:
+		p.hour, value, err = parseNumber(value, 2, 2)
+	case elementHourSpacePadded, elementHour12SpacePadded:
+		p.hour, value, err = parseNumber(strings.TrimPrefix(value, " "), 1, 2)
+	case elementMinute:
+		p.minute, value, err = parseNumber(value, 1, 2)
+	case elementMinutePadded:
+		p.minute, value, err = parseNumber(value, 2, 2)
+	case elementSecond:
+		p.second, value, err = parseNumber(value, 1, 2)
+	case elementSecondPadded:
+		p.second, value, err = parseNumber(value, 2, 2)
+	case elementFraction:
+		p.nanosecond, value, err = parseNumber(value, e.width, e.width)
+		p.nanosecond *= pow10(9 - e.width)
+	case elementAmPm, elementAmPmLower:
+		var i int
+		i, value, err = parseName(value, l.AmPm)
+		p.pm = i == 1
+		p.hasAmPm = true
+	case elementZoneAbbreviation, elementZoneName:
+		p.location, value, err = parseZone(value)
+	case elementOffset, elementOffsetColon, elementOffsetZ, elementOffsetColonZ:
+		p.location, value, err = parseOffset(value)
+	case elementUnix:
+		p.unix, value, err = parseUnix(value)
+		p.hasUnix = true
+	}
+
+	return value, err
+}
+
+func parseNumber(value string, minWidth, maxWidth int) (int, string, error) {
+	n := 0
+	i := 0
+
+	for ; i < maxWidth && i < len(value) && value[i] >= '0' && value[i] <= '9'; i++ {
+		n = n*10 + int(value[i]-'0')
+	}
+
+	if i < minWidth {
+		return 0, value, fmt.Errorf("%d digits", minWidth)
+	}
+
+	return n, value[i:], nil
+}
+
+func allNames(names locale.Names) [][]string {
+	return [][]string{names.Format.Wide, names.Standalone.Wide, names.Format.Abbreviated, names.Standalone.Abbreviated}
+}
+
+// parseName matches the longest name of lists and returns its index
+func parseName(value string, lists ...[]string) (int, string, error) {
+	best, bestLength := -1, 0
+
+	for _, names := range lists {
+		for i, name := range names {
+			if len(name) > bestLength && len(name) <= len(value) && strings.EqualFold(value[:len(name)], name) {
+				best, bestLength = i, len(name)
+			}
+		}
+	}
+
+	if best < 0 {
+		return 0, value, fmt.Errorf("one of %s", strings.Join(lists[0], ", "))
+	}
+
+	return best, value[bestLength:], nil
+}
+
+func parseUnix(value string) (int64, string, error) {
+	i := 0
+
+	if i < len(value) && value[i] == '-' {
+		i++
+	}
+
+	for i < len(value) && value[i] >= '0' && value[i] <= '9' {
+		i++
+	}
+
+	n, err := strconv.ParseInt(value[:i], 10, 64)
+
+	if err != nil {
+		return 0, value, errors.New("unix timestamp")
+	}
+
+	return n, value[i:], nil
+}
+
+func parseOffset(value string) (*time.Location, string, error) {
+	if strings.HasPrefix(value, "Z") {
+		return time.UTC, value[1:], nil
+	}
+
+	if value == "" || (value[0] != '+' && value[0] != '-') {
+		return nil, value, errors.New("time zone offset")
+	}
+
+	sign := 1
+	if value[0] == '-' {
+		sign = -1
+	}
+
+	hours, rest, err := parseNumber(value[1:], 2, 2)
+
+	if err != nil {
+		return nil, value, errors.New("time zone offset")
+	}
+
+	rest = strings.TrimPrefix(rest, ":")
+	minutes, rest, err := parseNumber(rest, 2, 2)
+
+	if err != nil {
+		return nil, value, errors.New("time zone offset")
+	}
+
+	offset := sign * (hours*3600 + minutes*60)
+
+	if offset == 0 {
+		return time.UTC, rest, nil
+	}
+
+	return time.FixedZone("", offset), rest, nil
+}
+
+func parseZone(value string) (*time.Location, string, error) {
+	i := 0
+
+	for i < len(value) && (isASCIILetter(value[i]) || strings.IndexByte("_/+-", value[i]) >= 0) {
+		i++
+	}
+
+	name := value[:i]
+
+	switch name {
+	case "":
+		return nil, value, errors.New("time zone")
+	case "Z", "UTC", "GMT":
+		return time.UTC, value[i:], nil
+	}
+
+	location, err := time.LoadLocation(name)
+
+	if err != nil {
+		return nil, value, fmt.Errorf("known time zone get \"%s\"", name)
+	}
+
+	return location, value[i:], nil
+}
+
+// Format formats date time by pattern
+func (d DateTime) Format(pattern Pattern) string {
+	return FormatTime(d.Time(), pattern)
+}
+
+// Parse parses value by pattern into DateTime in UTC
+func Parse(pattern Pattern, value string) (Interface, error) {
+	t, err := ParseTime(pattern, value)
+
+	if err != nil {
+		return nil, err
+	}
+
+	t = t.UTC()
+	d, err := fromTime(t)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return d, nil
+}
@@ -0,0 +1,49 @@
+package datetime
+
+import (
+	"gopkg.in/yaml.v3"
+)
+
+// WirePattern provides pattern of Formatted, it is a type, so that every struct field can have its own format, e.g.
+//
+//	type RFC3339 struct{}
+//
+//	func (RFC3339) Pattern() datetime.Pattern { return datetime.ICU("yyyy-MM-dd'T'HH:mm:ssXXX") }
+type WirePattern interface {
+	Pattern() Pattern
+}
+
+// Formatted DateTime marshaled to text, JSON and YAML by pattern of P instead of "2006-01-02 15:04:05", e.g.
+//
+//	Created datetime.Formatted[RFC3339] `json:"created"`
+type Formatted[P WirePattern] struct {
+	DateTime
+}
+
+func (f Formatted[P]) MarshalText() ([]byte, error) {
+	var p P
+
+	return f.DateTime.MarshalTextPattern(p.Pattern())
+}
+
+func (f *Formatted[P]) UnmarshalText(text []byte) error {
+	var p P
+
+	return f.DateTime.UnmarshalTextPattern(p.Pattern(), text)
+}
+
+func (f Formatted[P]) MarshalJSON() ([]byte, error) {
+	return MarshalJSONText(f)
+}
+
+func (f *Formatted[P]) UnmarshalJSON(data []byte) error {
+	return UnmarshalJSONText(f, data)
+}
+
+func (f Formatted[P]) MarshalYAML() (any, error) {
+	return MarshalYAMLText(f)
+}
+
+func (f *Formatted[P]) UnmarshalYAML(node *yaml.Node) error {
+	return UnmarshalYAMLText(f, node)
+}
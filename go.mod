@@ -6,6 +6,7 @@ require (
 	github.com/gouef/utils v1.9.4
 	github.com/gouef/validator v1.1.4
 	github.com/stretchr/testify v1.10.0
+	gopkg.in/yaml.v3 v3.0.1
 )
 
 replace (
@@ -15,9 +16,5 @@ replace (
 
 require (
 	github.com/davecgh/go-spew v1.1.1 // indirect
-	github.com/gouef/country v1.0.2 // indirect
-	github.com/gouef/currency v1.0.2 // indirect
 	github.com/pmezard/go-difflib v1.0.0 // indirect
-	golang.org/x/text v0.21.0 // indirect
-	gopkg.in/yaml.v3 v3.0.1 // indirect
 )
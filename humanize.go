@@ -0,0 +1,140 @@
+package datetime
+
+import (
+	"github.com/gouef/datetime/locale"
+	"math"
+	"time"
+)
+
+// Rounding of humanized amount of unit
+type Rounding int
+
+const (
+	// RoundHalfUp rounds to nearest, 1.5 hours is "2 hours"
+	RoundHalfUp Rounding = iota
+	// RoundDown truncates, 1.9 hours is "1 hour"
+	RoundDown
+	// RoundUp rounds up, 1.1 hours is "2 hours"
+	RoundUp
+)
+
+const (
+	averageDaysInMonth = 365.2425 / 12
+	averageDaysInYear  = 365.2425
+)
+
+// Thresholds amount of unit from which the next larger unit is used
+type Thresholds struct {
+	// Now durations below are humanized as "now"
+	Now    time.Duration
+	Second int
+	Minute int
+	Hour   int
+	Day    int
+	// Week zero disables weeks
+	Week  int
+	Month int
+}
+
+// DefaultThresholds 45 seconds, 45 minutes, 22 hours, 26 days and 11 months
+var DefaultThresholds = Thresholds{
+	Now:    10 * time.Second,
+	Second: 45,
+	Minute: 45,
+	Hour:   22,
+	Day:    26,
+	Month:  11,
+}
+
+// Humanizer produces relative phrases like "3 hours ago" or "za 2 dny"
+type Humanizer struct {
+	Locale     *locale.Locale
+	Thresholds Thresholds
+	Rounding   Rounding
+}
+
+// NewHumanizer humanizer of locale with DefaultThresholds
+func NewHumanizer(l *locale.Locale) *Humanizer {
+	return &Humanizer{
+		Locale:     l,
+		Thresholds: DefaultThresholds,
+		Rounding:   RoundHalfUp,
+	}
+}
+
+// Humanize returns english phrase of t relative to reference like "3 hours ago" or "in 2 days"
+func Humanize(t, reference Interface) string {
+	return NewHumanizer(locale.Default()).Humanize(t, reference)
+}
+
+// HumanizeDuration returns english phrase of duration like "2 days"
+func HumanizeDuration(d time.Duration) string {
+	return NewHumanizer(locale.Default()).Duration(d)
+}
+
+// Humanize returns phrase of t relative to reference
+func (h *Humanizer) Humanize(t, reference Interface) string {
+	d := t.Time().Sub(reference.Time())
+
+	if d.Abs() < h.Thresholds.Now {
+		return h.Locale.Relative.Now
+	}
+
+	unit, n := h.unit(d.Abs())
+
+	return h.Locale.FormatRelative(unit, n, d > 0)
+}
+
+// Duration returns phrase of duration
+func (h *Humanizer) Duration(d time.Duration) string {
+	unit, n := h.unit(d.Abs())
+
+	return h.Locale.FormatDuration(unit, n)
+}
+
+func (h *Humanizer) unit(d time.Duration) (locale.Unit, int) {
+	seconds := d.Seconds()
+
+	if n := h.round(seconds); n < h.Thresholds.Second {
+		return locale.UnitSecond, n
+	}
+
+	if n := h.round(seconds / 60); n < h.Thresholds.Minute {
+		return locale.UnitMinute, atLeastOne(n)
+	}
+
+	if n := h.round(seconds / 3600); n < h.Thresholds.Hour {
+		return locale.UnitHour, atLeastOne(n)
+	}
+
+	days := seconds / 86400
+
+	if n := h.round(days); n < h.Thresholds.Day {
+		return locale.UnitDay, atLeastOne(n)
+	}
+
+	if n := h.round(days / 7); n < h.Thresholds.Week {
+		return locale.UnitWeek, atLeastOne(n)
+	}
+
+	if n := h.round(days / averageDaysInMonth); n < h.Thresholds.Month {
+		return locale.UnitMonth, atLeastOne(n)
+	}
+
+	return locale.UnitYear, atLeastOne(h.round(days / averageDaysInYear))
+}
+
+func (h *Humanizer) round(value float64) int {
+	switch h.Rounding {
+	case RoundDown:
+		return int(math.Floor(value))
+	case RoundUp:
+		return int(math.Ceil(value))
+	}
+
+	return int(math.Floor(value + 0.5))
+}
+
+func atLeastOne(n int) int {
+	return max(n, 1)
+}
@@ -0,0 +1,110 @@
+package ical
+
+import (
+	"errors"
+	"github.com/gouef/datetime"
+	"github.com/gouef/datetime/date"
+	"github.com/gouef/datetime/internal/wallclock"
+	"time"
+)
+
+// DefaultProdID PRODID of exported calendar without ProdID
+const DefaultProdID = "-//gouef//datetime//EN"
+
+// ErrInvalidEvent event ends before it starts or mixes all day and date time values
+var ErrInvalidEvent = errors.New("invalid event")
+
+// Calendar VCALENDAR of RFC 5545, components other than VEVENT are ignored on import
+type Calendar struct {
+	// ProdID PRODID, DefaultProdID is exported when empty
+	ProdID string
+	// Name X-WR-CALNAME shown by calendar applications for subscribed calendars
+	Name   string
+	Events []Event
+}
+
+// Event VEVENT, Start and End of all day event are midnight in UTC
+type Event struct {
+	// UID unique id, exported UID is derived from Start and Summary when empty, so that it is stable between exports
+	UID         string
+	Summary     string
+	Description string
+	Location    string
+	Start       time.Time
+	// End DTEND, it is not included in event, on import it is computed from DURATION when event has no DTEND
+	End time.Time
+	// AllDay event of VALUE=DATE
+	AllDay bool
+	// Stamp DTSTAMP, current time is exported when zero
+	Stamp time.Time
+}
+
+// NewEvent returns event of date time range r with wall clock in location loc, nil loc is UTC.
+// Brackets of r are ignored, r without from returns date.ErrUnboundedRange and r without to returns date.ErrRangeWithoutTo
+func NewEvent(summary string, r *datetime.Range, loc *time.Location) (Event, error) {
+	if loc == nil {
+		loc = time.UTC
+	}
+
+	from, err := datetime.ParseString(string(r.From().(datetime.Value)))
+
+	if err != nil {
+		return Event{}, date.ErrUnboundedRange
+	}
+
+	to, err := datetime.ParseString(string(r.To().(datetime.Value)))
+
+	if err != nil {
+		return Event{}, date.ErrRangeWithoutTo
+	}
+
+	start, end := wallclock.In(from.Time(), loc), wallclock.In(to.Time(), loc)
+
+	if end.Before(start) {
+		return Event{}, ErrInvalidEvent
+	}
+
+	return Event{Summary: summary, Start: start, End: end}, nil
+}
+
+// NewAllDayEvent returns all day event of days r, both from and to of r are included, see NewEvent for errors
+func NewAllDayEvent(summary string, r *date.Range) (Event, error) {
+	from, err := date.ParseString(string(r.From()))
+
+	if err != nil {
+		return Event{}, date.ErrUnboundedRange
+	}
+
+	to, err := date.ParseString(string(r.To()))
+
+	if err != nil {
+		return Event{}, date.ErrRangeWithoutTo
+	}
+
+	if to.Before(from) {
+		return Event{}, ErrInvalidEvent
+	}
+
+	return Event{Summary: summary, Start: from.Time(), End: to.Time().AddDate(0, 0, 1), AllDay: true}, nil
+}
+
+// Range returns date time range [Start, End) as wall clock in location of Start
+func (e Event) Range() (*datetime.Range, error) {
+	end := e.End.In(e.Start.Location())
+
+	return datetime.NewRangeStartStrict(e.Start.Format(time.DateTime+".999999999"), end.Format(time.DateTime+".999999999"))
+}
+
+// Days returns days of event in location of Start, both from and to are included,
+// so that all day event of DTEND 2026-12-27 ends on 2026-12-26
+func (e Event) Days() (*date.Range, error) {
+	end := e.End.In(e.Start.Location())
+
+	if end.After(e.Start) {
+		end = end.Add(-time.Nanosecond)
+	} else {
+		end = e.Start
+	}
+
+	return date.NewRangeStrict(date.FromTime(e.Start).ToString(), date.FromTime(end).ToString())
+}
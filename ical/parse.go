@@ -0,0 +1,332 @@
+package ical
+
+import (
+	"fmt"
+	"github.com/gouef/datetime"
+	"github.com/gouef/datetime/internal/contentline"
+	"github.com/gouef/datetime/rrule"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// property content line "NAME;PARAMETER=value:value"
+type property struct {
+	name       string
+	parameters map[string]string
+	value      string
+}
+
+// Parse parses VCALENDAR with VEVENT components, e.g.
+//
+//	BEGIN:VCALENDAR
+//	BEGIN:VEVENT
+//	DTSTART;VALUE=DATE:20261224
+//	SUMMARY:Christmas Eve
+//	END:VEVENT
+//	END:VCALENDAR
+//
+// TZID is time zone of IANA database, DATE-TIME without TZID and "Z" is floating and it is in UTC like in package rrule.
+// Event without DTEND and DURATION lasts one day when it is all day, it is instant otherwise
+func Parse(value string) (*Calendar, error) {
+	var c *Calendar
+	var e *event
+	var components []string
+
+	for _, line := range contentline.Unfold(value) {
+		p, err := parseProperty(line)
+
+		if err != nil {
+			return nil, err
+		}
+
+		switch p.name {
+		case "BEGIN":
+			component := strings.ToUpper(p.value)
+			components = append(components, component)
+
+			switch {
+			case len(components) == 1 && component != "VCALENDAR":
+				return nil, &datetime.ParseError{Input: line, Expected: "BEGIN:VCALENDAR"}
+			case len(components) == 1 && c == nil:
+				c = &Calendar{}
+			case len(components) == 2 && component == "VEVENT":
+				e = &event{}
+			}
+		case "END":
+			if len(components) == 0 || components[len(components)-1] != strings.ToUpper(p.value) {
+				return nil, &datetime.ParseError{Input: line, Expected: "END of open component"}
+			}
+
+			if len(components) == 2 && e != nil {
+				parsed, err := e.build()
+
+				if err != nil {
+					return nil, err
+				}
+
+				c.Events = append(c.Events, parsed)
+				e = nil
+			}
+
+			components = components[:len(components)-1]
+		default:
+			switch {
+			case len(components) == 0:
+				return nil, &datetime.ParseError{Input: line, Expected: "BEGIN:VCALENDAR"}
+			case len(components) == 1:
+				c.set(p)
+			case len(components) == 2 && e != nil:
+				if err := e.set(p, line); err != nil {
+					return nil, err
+				}
+			}
+		}
+	}
+
+	if c == nil || len(components) > 0 {
+		return nil, &datetime.ParseError{Input: value, Expected: "BEGIN:VCALENDAR and END:VCALENDAR"}
+	}
+
+	return c, nil
+}
+
+func (c *Calendar) set(p property) {
+	switch p.name {
+	case "PRODID":
+		c.ProdID = p.value
+	case "X-WR-CALNAME":
+		c.Name = unescape(p.value)
+	}
+}
+
+// event VEVENT being parsed
+type event struct {
+	Event
+	hasStart bool
+	hasEnd   bool
+	endDate  bool
+	duration string
+	line     string
+}
+
+func (e *event) set(p property, line string) error {
+	var err error
+
+	switch p.name {
+	case "UID":
+		e.UID = p.value
+	case "SUMMARY":
+		e.Summary = unescape(p.value)
+	case "DESCRIPTION":
+		e.Description = unescape(p.value)
+	case "LOCATION":
+		e.Location = unescape(p.value)
+	case "DTSTAMP":
+		e.Stamp, _, err = parseTime(p, line)
+	case "DTSTART":
+		e.Start, e.AllDay, err = parseTime(p, line)
+		e.hasStart, e.line = true, line
+	case "DTEND":
+		e.End, e.endDate, err = parseTime(p, line)
+		e.hasEnd = true
+	case "DURATION":
+		e.duration = p.value
+	}
+
+	return err
+}
+
+func (e *event) build() (Event, error) {
+	if !e.hasStart {
+		return Event{}, &datetime.ParseError{Input: "VEVENT " + e.Summary, Expected: "DTSTART"}
+	}
+
+	switch {
+	case e.hasEnd && e.endDate != e.AllDay:
+		return Event{}, fmt.Errorf("%w: DTSTART and DTEND of %q are not both dates", ErrInvalidEvent, e.line)
+	case e.hasEnd:
+	case e.duration != "":
+		days, duration, err := parseDuration(e.duration)
+
+		if err != nil {
+			return Event{}, err
+		}
+
+		e.End = e.Start.AddDate(0, 0, days).Add(duration)
+	case e.AllDay:
+		e.End = e.Start.AddDate(0, 0, 1)
+	default:
+		e.End = e.Start
+	}
+
+	if e.End.Before(e.Start) {
+		return Event{}, fmt.Errorf("%w: %q ends before it starts", ErrInvalidEvent, e.line)
+	}
+
+	return e.Event, nil
+}
+
+// parseTime parses DATE or DATE-TIME of property, all day is true for DATE
+func parseTime(p property, line string) (time.Time, bool, error) {
+	loc := time.UTC
+
+	if zone, ok := p.parameters["TZID"]; ok {
+		location, err := time.LoadLocation(zone)
+
+		if err != nil {
+			return time.Time{}, false, &datetime.ParseError{Input: line, Expected: "known TZID", Err: err}
+		}
+
+		loc = location
+	}
+
+	allDay := strings.EqualFold(p.parameters["VALUE"], "DATE") || len(p.value) == len("20060102")
+
+	if allDay {
+		loc = time.UTC
+	}
+
+	t, err := rrule.ParseTime(p.value, loc)
+
+	return t, allDay, err
+}
+
+// parseDuration parses DURATION, e.g. "P1D", "PT1H30M" or "-P1W", weeks and days are returned as days,
+// because their length depends on daylight saving time
+func parseDuration(value string) (int, time.Duration, error) {
+	invalid := &datetime.ParseError{Input: value, Expected: "duration \"P1W\", \"P1DT2H\" or \"PT1H30M\""}
+	body, sign := value, 1
+
+	switch {
+	case strings.HasPrefix(body, "-"):
+		body, sign = body[1:], -1
+	case strings.HasPrefix(body, "+"):
+		body = body[1:]
+	}
+
+	if !strings.HasPrefix(body, "P") || len(body) < 3 {
+		return 0, 0, invalid
+	}
+
+	days, duration, inTime, start := 0, time.Duration(0), false, 1
+
+	for i := 1; i < len(body); i++ {
+		unit := body[i]
+
+		if unit >= '0' && unit <= '9' {
+			continue
+		}
+
+		if unit == 'T' && !inTime && i == start {
+			inTime, start = true, i+1
+			continue
+		}
+
+		n, err := strconv.Atoi(body[start:i])
+
+		if err != nil {
+			invalid.Position = len(value) - len(body) + start
+
+			return 0, 0, invalid
+		}
+
+		switch {
+		case unit == 'W' && !inTime:
+			days += 7 * n
+		case unit == 'D' && !inTime:
+			days += n
+		case unit == 'H' && inTime:
+			duration += time.Duration(n) * time.Hour
+		case unit == 'M' && inTime:
+			duration += time.Duration(n) * time.Minute
+		case unit == 'S' && inTime:
+			duration += time.Duration(n) * time.Second
+		default:
+			invalid.Position = len(value) - len(body) + i
+
+			return 0, 0, invalid
+		}
+
+		start = i + 1
+	}
+
+	if start != len(body) || strings.HasSuffix(body, "T") {
+		return 0, 0, invalid
+	}
+
+	return sign * days, time.Duration(sign) * duration, nil
+}
+
+// parseProperty parses content line, names of property and parameters are upper case
+func parseProperty(line string) (property, error) {
+	p := property{parameters: map[string]string{}}
+	end := strings.IndexAny(line, ";:")
+
+	if end <= 0 {
+		return property{}, &datetime.ParseError{Input: line, Expected: "content line \"NAME:VALUE\""}
+	}
+
+	p.name = strings.ToUpper(line[:end])
+
+	for i := end; i < len(line); {
+		if line[i] == ':' {
+			p.value = line[i+1:]
+
+			return p, nil
+		}
+
+		// line[i] is ';' before parameter
+		key, rest, ok := strings.Cut(line[i+1:], "=")
+
+		if !ok {
+			return property{}, &datetime.ParseError{Input: line, Position: i + 1, Expected: "parameter \"NAME=value\""}
+		}
+
+		i += 1 + len(key) + 1
+		start := i
+
+		if strings.HasPrefix(rest, `"`) {
+			closing := strings.IndexByte(rest[1:], '"')
+
+			if closing < 0 {
+				return property{}, &datetime.ParseError{Input: line, Position: start, Expected: "closing quote"}
+			}
+
+			i += closing + 2
+		} else {
+			i += strings.IndexAny(rest+":", ";:")
+		}
+
+		if i >= len(line) || (line[i] != ';' && line[i] != ':') {
+			return property{}, &datetime.ParseError{Input: line, Position: i, Expected: "\";\" or \":\" after parameter"}
+		}
+
+		p.parameters[strings.ToUpper(key)] = strings.Trim(line[start:i], `"`)
+	}
+
+	return property{}, &datetime.ParseError{Input: line, Position: len(line), Expected: "\":\" before value"}
+}
+
+// unescape returns TEXT value without escaping of backslash, semicolon, comma and new line
+func unescape(value string) string {
+	var b strings.Builder
+
+	for i := 0; i < len(value); i++ {
+		if value[i] != '\\' || i+1 == len(value) {
+			b.WriteByte(value[i])
+			continue
+		}
+
+		i++
+
+		switch value[i] {
+		case 'n', 'N':
+			b.WriteByte('\n')
+		default:
+			b.WriteByte(value[i])
+		}
+	}
+
+	return b.String()
+}
@@ -0,0 +1,152 @@
+package ical
+
+import (
+	"context"
+	"fmt"
+	"github.com/gouef/datetime"
+	"hash/fnv"
+	"io"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// maxLineLength octets of content line, longer lines are folded
+const maxLineLength = 75
+
+// WriteTo writes c as VCALENDAR with CRLF line endings and folded lines.
+// Date times are in UTC or with TZID of their location, TZID refers to IANA time zone without VTIMEZONE component,
+// which calendar applications accept, time.Local is written in UTC, because it has no IANA name
+func (c *Calendar) WriteTo(w io.Writer) (int64, error) {
+	n, err := io.WriteString(w, c.String())
+
+	return int64(n), err
+}
+
+// String returns c as VCALENDAR, see WriteTo
+func (c *Calendar) String() string {
+	prodID := c.ProdID
+
+	if prodID == "" {
+		prodID = DefaultProdID
+	}
+
+	lines := []string{"BEGIN:VCALENDAR", "VERSION:2.0", "PRODID:" + prodID, "CALSCALE:GREGORIAN"}
+
+	if c.Name != "" {
+		lines = append(lines, "X-WR-CALNAME:"+escape(c.Name))
+	}
+
+	for _, e := range c.Events {
+		lines = append(lines, e.lines()...)
+	}
+
+	lines = append(lines, "END:VCALENDAR")
+
+	var b strings.Builder
+
+	for _, line := range lines {
+		fold(&b, line)
+	}
+
+	return b.String()
+}
+
+func (e Event) lines() []string {
+	stamp := e.Stamp
+
+	if stamp.IsZero() {
+		stamp = datetime.ClockFromContext(context.Background()).Now().UTC()
+	}
+
+	start := formatTime("DTSTART", e.Start, e.AllDay)
+	uid := e.UID
+
+	if uid == "" {
+		uid = e.uid(start)
+	}
+
+	lines := []string{
+		"BEGIN:VEVENT",
+		"UID:" + uid,
+		"DTSTAMP:" + stamp.UTC().Format("20060102T150405Z"),
+		start,
+		formatTime("DTEND", e.End, e.AllDay),
+	}
+
+	for _, property := range [][2]string{{"SUMMARY", e.Summary}, {"DESCRIPTION", e.Description}, {"LOCATION", e.Location}} {
+		if property[1] != "" {
+			lines = append(lines, property[0]+":"+escape(property[1]))
+		}
+	}
+
+	return append(lines, "END:VEVENT")
+}
+
+// uid returns UID derived from DTSTART and Summary, so that calendar applications recognise the event in next export
+func (e Event) uid(start string) string {
+	h := fnv.New64a()
+	h.Write([]byte(start + "\n" + e.Summary))
+
+	return fmt.Sprintf("%016x@gouef-datetime", h.Sum64())
+}
+
+// formatTime returns property name of t, t in location which is not in IANA database, e.g. time.FixedZone, is in UTC
+func formatTime(name string, t time.Time, allDay bool) string {
+	switch {
+	case allDay:
+		return name + ";VALUE=DATE:" + t.Format("20060102")
+	case !isIANA(t):
+		return name + ":" + t.UTC().Format("20060102T150405Z")
+	default:
+		return name + ";TZID=" + t.Location().String() + ":" + t.Format("20060102T150405")
+	}
+}
+
+// isIANA returns true when location of t is time zone of IANA database with the same offset at t, so that TZID refers to it
+func isIANA(t time.Time) bool {
+	loc := t.Location()
+
+	if loc == time.UTC || loc == time.Local || loc.String() == "" {
+		return false
+	}
+
+	zone, err := time.LoadLocation(loc.String())
+
+	if err != nil {
+		return false
+	}
+
+	_, offset := t.Zone()
+	_, expected := t.In(zone).Zone()
+
+	return offset == expected
+}
+
+// fold writes line ended by CRLF to b, line longer than 75 octets continues on lines starting with space,
+// UTF-8 sequences are not split
+func fold(b *strings.Builder, line string) {
+	limit := maxLineLength
+
+	for len(line) > limit {
+		i := limit
+
+		for i > 0 && !utf8.RuneStart(line[i]) {
+			i--
+		}
+
+		b.WriteString(line[:i])
+		b.WriteString("\r\n ")
+		line = line[i:]
+		// the leading space is counted
+		limit = maxLineLength - 1
+	}
+
+	b.WriteString(line)
+	b.WriteString("\r\n")
+}
+
+// escape returns TEXT value with escaped backslash, semicolon, comma and new line
+func escape(value string) string {
+	return strings.NewReplacer(`\`, `\\`, ";", `\;`, ",", `\,`, "\r\n", `\n`, "\n", `\n`).Replace(value)
+}
@@ -0,0 +1,20 @@
+package contentline
+
+import "strings"
+
+// Unfold returns content lines of value without empty lines, lines starting with space or tab continue the previous line
+func Unfold(value string) []string {
+	var lines []string
+
+	for _, line := range strings.Split(strings.ReplaceAll(value, "\r\n", "\n"), "\n") {
+		switch {
+		case line == "":
+		case (line[0] == ' ' || line[0] == '\t') && len(lines) > 0:
+			lines[len(lines)-1] += line[1:]
+		default:
+			lines = append(lines, line)
+		}
+	}
+
+	return lines
+}
@@ -0,0 +1,50 @@
+package scan
+
+// Digits returns number of n ASCII digits at value[i:], ok is false when there are not n digits
+func Digits(value string, i, n int) (int, bool) {
+	if i < 0 || i+n > len(value) {
+		return 0, false
+	}
+
+	number := 0
+
+	for ; n > 0; i, n = i+1, n-1 {
+		if !IsDigit(value[i]) {
+			return 0, false
+		}
+
+		number = number*10 + int(value[i]-'0')
+	}
+
+	return number, true
+}
+
+// Fraction scans optional fraction of second ".123456789" at value[i:] and returns nanoseconds and index after it,
+// digits after the ninth are not scanned, ok is false when "." is not followed by a digit
+func Fraction(value string, i int) (nanosecond int, next int, ok bool) {
+	if i >= len(value) || value[i] != '.' {
+		return 0, i, true
+	}
+
+	digits := 0
+
+	for next = i + 1; next < len(value) && digits < 9 && IsDigit(value[next]); next++ {
+		nanosecond = nanosecond*10 + int(value[next]-'0')
+		digits++
+	}
+
+	if digits == 0 {
+		return 0, i, false
+	}
+
+	for ; digits < 9; digits++ {
+		nanosecond *= 10
+	}
+
+	return nanosecond, next, true
+}
+
+// IsDigit returns true when c is ASCII digit
+func IsDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
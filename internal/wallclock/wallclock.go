@@ -0,0 +1,43 @@
+package wallclock
+
+import "time"
+
+// Of returns wall clock of t in UTC
+func Of(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), time.UTC)
+}
+
+// In returns instant of wall clock w in loc like section 3.3.5 of RFC 5545, w skipped by daylight saving time
+// uses UTC offset before the gap, e.g. 02:30 is 03:30 when clocks skip from 02:00 to 03:00,
+// and w repeated when daylight saving time ends is its first occurrence
+func In(w time.Time, loc *time.Location) time.Time {
+	w = Of(w)
+	t := time.Date(w.Year(), w.Month(), w.Day(), w.Hour(), w.Minute(), w.Second(), w.Nanosecond(), loc)
+	wall := Of(t)
+
+	if wall.Before(w) {
+		// time.Date moved w before the gap, t has offset before the gap
+		_, before := t.Zone()
+		return w.Add(-time.Duration(before) * time.Second).In(loc)
+	}
+
+	start, _ := t.ZoneBounds()
+
+	if start.IsZero() {
+		return t
+	}
+
+	// offset of zone before zone of t is offset before the gap or offset of the first occurrence of w
+	_, before := start.Add(-time.Nanosecond).Zone()
+	earlier := w.Add(-time.Duration(before) * time.Second).In(loc)
+
+	switch {
+	case wall.After(w):
+		// time.Date moved w after the gap
+		return earlier
+	case earlier.Before(t) && Of(earlier).Equal(w):
+		return earlier
+	default:
+		return t
+	}
+}
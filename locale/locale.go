@@ -0,0 +1,211 @@
+package locale
+
+import (
+	"embed"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"path"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+//go:embed data/*.json
+var data embed.FS
+
+// Width of month and weekday names
+type Width string
+
+// Context of month and weekday names, format is used inside of date ("18. října"), standalone alone ("říjen")
+type Context string
+
+// Style of predefined date and time formats
+type Style string
+
+const (
+	// WidthWide full names like "October"
+	WidthWide Width = "wide"
+	// WidthAbbreviated short names like "Oct"
+	WidthAbbreviated Width = "abbreviated"
+
+	// ContextFormat names used inside of date
+	ContextFormat Context = "format"
+	// ContextStandalone names used alone
+	ContextStandalone Context = "standalone"
+
+	StyleShort  Style = "short"
+	StyleMedium Style = "medium"
+	StyleLong   Style = "long"
+	StyleFull   Style = "full"
+)
+
+// NameSet names in all widths
+type NameSet struct {
+	Wide        []string `json:"wide"`
+	Abbreviated []string `json:"abbreviated"`
+}
+
+// Names names in all contexts (grammatical cases)
+type Names struct {
+	Format     NameSet `json:"format"`
+	Standalone NameSet `json:"standalone"`
+}
+
+// Locale month and weekday names and date/time formats of language
+type Locale struct {
+	Tag            string           `json:"tag"`
+	Months         Names            `json:"months"`
+	Weekdays       Names            `json:"weekdays"`
+	AmPm           []string         `json:"amPm"`
+	DateFormats    map[Style]string `json:"dateFormats"`
+	TimeFormats    map[Style]string `json:"timeFormats"`
+	DateTimeLayout string           `json:"dateTimeFormat"`
+	Relative       Relative         `json:"relative"`
+}
+
+// ErrUnsupportedLocale locale of tag is not embedded
+var ErrUnsupportedLocale = errors.New("unsupported locale")
+
+var (
+	locales     map[string]*Locale
+	localesErr  error
+	localesOnce sync.Once
+)
+
+func load() {
+	locales = map[string]*Locale{}
+	entries, err := data.ReadDir("data")
+
+	if err != nil {
+		localesErr = err
+		return
+	}
+
+	for _, entry := range entries {
+		content, err := data.ReadFile(path.Join("data", entry.Name()))
+
+		if err != nil {
+			localesErr = err
+			return
+		}
+
+		l := &Locale{}
+
+		if err := json.Unmarshal(content, l); err != nil {
+			localesErr = fmt.Errorf("invalid locale data \"%s\": %w", entry.Name(), err)
+			return
+		}
+
+		l.normalize()
+		locales[l.Tag] = l
+	}
+}
+
+func (l *Locale) normalize() {
+	for _, names := range []*Names{&l.Months, &l.Weekdays} {
+		if len(names.Standalone.Wide) == 0 {
+			names.Standalone.Wide = names.Format.Wide
+		}
+
+		if len(names.Standalone.Abbreviated) == 0 {
+			names.Standalone.Abbreviated = names.Format.Abbreviated
+		}
+	}
+}
+
+// Get returns locale by tag, "cs-CZ" and "cs_CZ" fall back to "cs"
+func Get(tag string) (*Locale, error) {
+	localesOnce.Do(load)
+
+	if localesErr != nil {
+		return nil, localesErr
+	}
+
+	tag = strings.ToLower(strings.ReplaceAll(tag, "_", "-"))
+
+	if l, ok := locales[tag]; ok {
+		return l, nil
+	}
+
+	if i := strings.IndexByte(tag, '-'); i > 0 {
+		if l, ok := locales[tag[:i]]; ok {
+			return l, nil
+		}
+	}
+
+	return nil, fmt.Errorf("%w \"%s\"", ErrUnsupportedLocale, tag)
+}
+
+// MustGet returns locale by tag and panics if it does not exist
+func MustGet(tag string) *Locale {
+	l, err := Get(tag)
+
+	if err != nil {
+		panic(err)
+	}
+
+	return l
+}
+
+// Default returns english locale
+func Default() *Locale {
+	return MustGet("en")
+}
+
+// Available returns tags of all embedded locales
+func Available() []string {
+	localesOnce.Do(load)
+
+	var tags []string
+
+	for tag := range locales {
+		tags = append(tags, tag)
+	}
+
+	sort.Strings(tags)
+
+	return tags
+}
+
+func (n Names) set(context Context) NameSet {
+	if context == ContextStandalone {
+		return n.Standalone
+	}
+
+	return n.Format
+}
+
+func (s NameSet) names(width Width) []string {
+	if width == WidthAbbreviated {
+		return s.Abbreviated
+	}
+
+	return s.Wide
+}
+
+// MonthName returns name of month
+func (l *Locale) MonthName(month time.Month, context Context, width Width) string {
+	return l.Months.set(context).names(width)[month-1]
+}
+
+// WeekdayName returns name of weekday
+func (l *Locale) WeekdayName(weekday time.Weekday, context Context, width Width) string {
+	return l.Weekdays.set(context).names(width)[weekday]
+}
+
+// DateFormat returns ICU pattern of date in style
+func (l *Locale) DateFormat(style Style) string {
+	return l.DateFormats[style]
+}
+
+// TimeFormat returns ICU pattern of time in style
+func (l *Locale) TimeFormat(style Style) string {
+	return l.TimeFormats[style]
+}
+
+// DateTimeFormat returns ICU pattern of date and time in styles
+func (l *Locale) DateTimeFormat(dateStyle, timeStyle Style) string {
+	return strings.NewReplacer("{0}", l.TimeFormat(timeStyle), "{1}", l.DateFormat(dateStyle)).Replace(l.DateTimeLayout)
+}
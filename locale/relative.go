@@ -0,0 +1,128 @@
+package locale
+
+import (
+	"strconv"
+	"strings"
+)
+
+// Plural category of number
+type Plural string
+
+// Unit of relative time
+type Unit string
+
+const (
+	PluralOne   Plural = "one"
+	PluralFew   Plural = "few"
+	PluralMany  Plural = "many"
+	PluralOther Plural = "other"
+
+	UnitSecond Unit = "second"
+	UnitMinute Unit = "minute"
+	UnitHour   Unit = "hour"
+	UnitDay    Unit = "day"
+	UnitWeek   Unit = "week"
+	UnitMonth  Unit = "month"
+	UnitYear   Unit = "year"
+)
+
+// PluralForms phrase for each plural category, "{0}" is replaced by number
+type PluralForms map[Plural]string
+
+// RelativeUnit phrases of unit, Future and Past are optional and default to wrapped Duration
+type RelativeUnit struct {
+	Duration PluralForms `json:"duration"`
+	Future   PluralForms `json:"future"`
+	Past     PluralForms `json:"past"`
+}
+
+// Relative phrases of relative time
+type Relative struct {
+	Now    string                `json:"now"`
+	Future string                `json:"future"`
+	Past   string                `json:"past"`
+	Units  map[Unit]RelativeUnit `json:"units"`
+}
+
+// pluralRules CLDR cardinal plural rules for integers by language
+var pluralRules = map[string]func(n int) Plural{
+	"cs": slavicPlural,
+	"sk": slavicPlural,
+	"pl": polishPlural,
+	"fr": func(n int) Plural {
+		if n == 0 || n == 1 {
+			return PluralOne
+		}
+		return PluralOther
+	},
+}
+
+func slavicPlural(n int) Plural {
+	switch {
+	case n == 1:
+		return PluralOne
+	case n >= 2 && n <= 4:
+		return PluralFew
+	}
+
+	return PluralOther
+}
+
+func polishPlural(n int) Plural {
+	switch {
+	case n == 1:
+		return PluralOne
+	case n%10 >= 2 && n%10 <= 4 && (n%100 < 12 || n%100 > 14):
+		return PluralFew
+	}
+
+	return PluralMany
+}
+
+// Plural returns plural category of n
+func (l *Locale) Plural(n int) Plural {
+	if n < 0 {
+		n = -n
+	}
+
+	if rule, ok := pluralRules[l.Tag]; ok {
+		return rule(n)
+	}
+
+	if n == 1 {
+		return PluralOne
+	}
+
+	return PluralOther
+}
+
+func (f PluralForms) format(plural Plural, n int) string {
+	form, ok := f[plural]
+
+	if !ok {
+		form = f[PluralOther]
+	}
+
+	return strings.ReplaceAll(form, "{0}", strconv.Itoa(n))
+}
+
+// FormatDuration returns phrase like "5 dní"
+func (l *Locale) FormatDuration(unit Unit, n int) string {
+	return l.Relative.Units[unit].Duration.format(l.Plural(n), n)
+}
+
+// FormatRelative returns phrase like "za 5 dní" or "před 5 dny"
+func (l *Locale) FormatRelative(unit Unit, n int, future bool) string {
+	u := l.Relative.Units[unit]
+	forms, wrapper := u.Past, l.Relative.Past
+
+	if future {
+		forms, wrapper = u.Future, l.Relative.Future
+	}
+
+	if len(forms) > 0 {
+		return forms.format(l.Plural(n), n)
+	}
+
+	return strings.ReplaceAll(wrapper, "{0}", l.FormatDuration(unit, n))
+}
@@ -0,0 +1,187 @@
+package datetime
+
+import (
+	"encoding"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+const (
+	binaryVersion byte = 1
+	// binaryVersionNano adds 4 bytes of nanoseconds, it is used only when nanosecond is not zero
+	binaryVersionNano byte = 2
+)
+
+func (d DateTime) MarshalText() ([]byte, error) {
+	return []byte(d.ToString()), nil
+}
+
+func (d *DateTime) UnmarshalText(text []byte) error {
+	parsed, err := FromString(string(text))
+
+	if err != nil {
+		return err
+	}
+
+	*d = *parsed.(*DateTime)
+
+	return nil
+}
+
+// MarshalTextPattern returns d formatted by pattern, see Formatted for fields marshaled by pattern
+func (d DateTime) MarshalTextPattern(pattern Pattern) ([]byte, error) {
+	return []byte(d.Format(pattern)), nil
+}
+
+// UnmarshalTextPattern parses text by pattern into d
+func (d *DateTime) UnmarshalTextPattern(pattern Pattern, text []byte) error {
+	parsed, err := Parse(pattern, string(text))
+
+	if err != nil {
+		return err
+	}
+
+	*d = *parsed.(*DateTime)
+
+	return nil
+}
+
+func (d DateTime) MarshalJSON() ([]byte, error) {
+	return MarshalJSONText(d)
+}
+
+func (d *DateTime) UnmarshalJSON(data []byte) error {
+	return UnmarshalJSONText(d, data)
+}
+
+func (d DateTime) MarshalBinary() ([]byte, error) {
+	if d.Nanosecond() == 0 {
+		data := make([]byte, 9)
+		data[0] = binaryVersion
+		binary.BigEndian.PutUint64(data[1:], uint64(d.Time().Unix()))
+
+		return data, nil
+	}
+
+	data := make([]byte, 13)
+	data[0] = binaryVersionNano
+	binary.BigEndian.PutUint64(data[1:], uint64(d.Time().Unix()))
+	binary.BigEndian.PutUint32(data[9:], uint32(d.Nanosecond()))
+
+	return data, nil
+}
+
+func (d *DateTime) UnmarshalBinary(data []byte) error {
+	nanosecond := int64(0)
+
+	switch {
+	case len(data) == 9 && data[0] == binaryVersion:
+	case len(data) == 13 && data[0] == binaryVersionNano:
+		nanosecond = int64(binary.BigEndian.Uint32(data[9:]))
+	default:
+		return fmt.Errorf("%w of date time", ErrInvalidBinary)
+	}
+
+	t := time.Unix(int64(binary.BigEndian.Uint64(data[1:])), nanosecond).UTC()
+	parsed, err := fromTime(t)
+
+	if err != nil {
+		return err
+	}
+
+	*d = *parsed
+
+	return nil
+}
+
+func (d Value) MarshalText() ([]byte, error) {
+	return []byte(d), nil
+}
+
+func (d *Value) UnmarshalText(text []byte) error {
+	value, err := StringToValue(string(text))
+
+	if err != nil {
+		return err
+	}
+
+	*d = value
+
+	return nil
+}
+
+func (d Value) MarshalJSON() ([]byte, error) {
+	return MarshalJSONText(d)
+}
+
+func (d *Value) UnmarshalJSON(data []byte) error {
+	return UnmarshalJSONText(d, data)
+}
+
+func (d Value) MarshalBinary() ([]byte, error) {
+	return d.MarshalText()
+}
+
+func (d *Value) UnmarshalBinary(data []byte) error {
+	return d.UnmarshalText(data)
+}
+
+func (r Range) MarshalText() ([]byte, error) {
+	return []byte(r.String()), nil
+}
+
+func (r *Range) UnmarshalText(text []byte) error {
+	parsed, err := RangeFromString(string(text))
+
+	if err != nil {
+		return err
+	}
+
+	*r = *parsed
+
+	return nil
+}
+
+func (r Range) MarshalJSON() ([]byte, error) {
+	return MarshalJSONText(r)
+}
+
+func (r *Range) UnmarshalJSON(data []byte) error {
+	return UnmarshalJSONText(r, data)
+}
+
+func (r Range) MarshalBinary() ([]byte, error) {
+	return r.MarshalText()
+}
+
+func (r *Range) UnmarshalBinary(data []byte) error {
+	return r.UnmarshalText(data)
+}
+
+// MarshalJSONText marshals text of value as JSON string
+func MarshalJSONText(value encoding.TextMarshaler) ([]byte, error) {
+	text, err := value.MarshalText()
+
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(string(text))
+}
+
+// UnmarshalJSONText unmarshals JSON string into value by its UnmarshalText, null is ignored
+func UnmarshalJSONText(value encoding.TextUnmarshaler, data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+
+	var text string
+
+	if err := json.Unmarshal(data, &text); err != nil {
+		return &ParseError{Input: string(data), Expected: "JSON string"}
+	}
+
+	return value.UnmarshalText([]byte(text))
+}
@@ -0,0 +1,333 @@
+package datetime
+
+import (
+	"errors"
+	"fmt"
+	"github.com/gouef/datetime/locale"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// DateOrder order of day and month in numeric dates
+type DateOrder int
+
+const (
+	// OrderUnknown layout is not ambiguous or order is not known
+	OrderUnknown DateOrder = iota
+	// OrderDayFirst 18/10/2026
+	OrderDayFirst
+	// OrderMonthFirst 10/18/2026
+	OrderMonthFirst
+)
+
+// NumericMode how plain numbers are interpreted by ParseAny
+type NumericMode int
+
+const (
+	// NumericAuto numbers with 8 or more digits are Unix seconds, up to 5 integer digits are Excel serials
+	NumericAuto NumericMode = iota
+	// NumericUnix numbers are Unix seconds
+	NumericUnix
+	// NumericExcel numbers are Excel serial dates (1900 date system)
+	NumericExcel
+	// NumericNone numbers are not accepted
+	NumericNone
+)
+
+// ErrAmbiguousDate value matches more layouts with different results
+var ErrAmbiguousDate = errors.New("ambiguous date")
+
+// Layout named pattern with order of day and month
+type Layout struct {
+	Name    string
+	Pattern Pattern
+	Order   DateOrder
+}
+
+var (
+	// LayoutUnix layout reported for Unix seconds
+	LayoutUnix = Layout{Name: "unix", Pattern: PHP("U")}
+	// LayoutExcel layout reported for Excel serial dates
+	LayoutExcel = Layout{Name: "excel"}
+)
+
+// DefaultLayouts layouts tried by ParseAny when ParseAnyOptions.Layouts is empty
+var DefaultLayouts = []Layout{
+	{"ISO 8601", ICU("yyyy-MM-dd'T'HH:mm:ssXXX"), OrderUnknown},
+	{"ISO 8601 offset", ICU("yyyy-MM-dd'T'HH:mm:ssxx"), OrderUnknown},
+	{"ISO 8601 local", ICU("yyyy-MM-dd'T'HH:mm:ss"), OrderUnknown},
+	{"ISO 8601 minutes", ICU("yyyy-MM-dd'T'HH:mm"), OrderUnknown},
+	{"date time", ICU("yyyy-MM-dd HH:mm:ss"), OrderUnknown},
+	{"date time minutes", ICU("yyyy-MM-dd HH:mm"), OrderUnknown},
+	{"date", ICU("yyyy-MM-dd"), OrderUnknown},
+	{"date slash", ICU("yyyy/MM/dd"), OrderUnknown},
+	{"dot date time", ICU("d.M.yyyy H:mm:ss"), OrderDayFirst},
+	{"dot date time minutes", ICU("d.M.yyyy H:mm"), OrderDayFirst},
+	{"dot date", ICU("d.M.yyyy"), OrderDayFirst},
+	{"spaced dot date", ICU("d. M. yyyy"), OrderDayFirst},
+	{"day first slash date time", ICU("d/M/yyyy H:mm:ss"), OrderDayFirst},
+	{"day first slash date time minutes", ICU("d/M/yyyy H:mm"), OrderDayFirst},
+	{"day first slash date", ICU("d/M/yyyy"), OrderDayFirst},
+	{"day first dash date", ICU("d-M-yyyy"), OrderDayFirst},
+	{"month first slash date time", ICU("M/d/yyyy H:mm:ss"), OrderMonthFirst},
+	{"month first slash date time minutes", ICU("M/d/yyyy H:mm"), OrderMonthFirst},
+	{"month first slash date", ICU("M/d/yyyy"), OrderMonthFirst},
+	{"month first dash date", ICU("M-d-yyyy"), OrderMonthFirst},
+	{"RFC 1123", ICU("EEE, dd MMM yyyy HH:mm:ss z"), OrderUnknown},
+	{"RFC 1123 offset", ICU("EEE, dd MMM yyyy HH:mm:ss Z"), OrderUnknown},
+	{"long date", ICU("d MMMM yyyy"), OrderUnknown},
+	{"long month first date", ICU("MMMM d, yyyy"), OrderUnknown},
+}
+
+// ParseAnyOptions options of ParseAny, zero value uses DefaultLayouts, NumericAuto and no order hint
+type ParseAnyOptions struct {
+	Layouts []Layout
+	Order   DateOrder
+	// Locale order hint taken from short date format when Order is OrderUnknown
+	Locale  *locale.Locale
+	Numeric NumericMode
+}
+
+// ParseAnyResult parsed date time and layout which matched
+type ParseAnyResult struct {
+	DateTime *DateTime
+	Layout   Layout
+	// Ambiguous more layouts matched with different results and the order hint decided
+	Ambiguous bool
+	// Candidates layouts which matched value
+	Candidates []Layout
+}
+
+type anyMatch struct {
+	layout Layout
+	time   time.Time
+}
+
+// ParseAny parses value by the first matching layout, ambiguous values (10/11/2026) are resolved
+// by order hint, without hint ErrAmbiguousDate is returned together with result listing Candidates
+func ParseAny(value string, options ParseAnyOptions) (*ParseAnyResult, error) {
+	value = strings.TrimSpace(value)
+	order := options.order()
+
+	if layout, t, ok, err := parseNumeric(value, options.Numeric); ok {
+		if err != nil {
+			return nil, err
+		}
+
+		return newParseAnyResult(anyMatch{layout, t}, false, []Layout{layout})
+	}
+
+	var matches []anyMatch
+
+	for _, layout := range options.layouts() {
+		if t, err := ParseTime(layout.Pattern, value); err == nil {
+			matches = append(matches, anyMatch{layout, t})
+		}
+	}
+
+	if len(matches) == 0 {
+		return nil, &ParseError{Input: value, Expected: "date time of supported layout"}
+	}
+
+	candidates := make([]Layout, len(matches))
+
+	for i, m := range matches {
+		candidates[i] = m.layout
+	}
+
+	if !matches[0].differs(matches) {
+		return newParseAnyResult(matches[0], false, candidates)
+	}
+
+	for _, m := range matches {
+		if order != OrderUnknown && m.layout.Order == order {
+			return newParseAnyResult(m, true, candidates)
+		}
+	}
+
+	return &ParseAnyResult{Ambiguous: true, Candidates: candidates},
+		fmt.Errorf("%w \"%s\" matches %s", ErrAmbiguousDate, value, layoutNames(candidates))
+}
+
+// DetectLayout returns the first layout parsing all non-empty values, so that a whole column
+// is parsed with one consistent format. Values like 25/10/2026 decide order for 10/11/2026.
+func DetectLayout(values []string, options ParseAnyOptions) (Layout, error) {
+	order := options.order()
+	var matching []Layout
+
+	if layout, ok := numericLayout(values, options.Numeric); ok {
+		return layout, nil
+	}
+
+	for _, layout := range options.layouts() {
+		if parsesAll(layout, values) {
+			matching = append(matching, layout)
+		}
+	}
+
+	if len(matching) == 0 {
+		return Layout{}, fmt.Errorf("%w, no layout parses all values", ErrInvalidFormat)
+	}
+
+	orders := map[DateOrder]bool{}
+
+	for _, layout := range matching {
+		orders[layout.Order] = true
+	}
+
+	if orders[OrderDayFirst] && orders[OrderMonthFirst] {
+		for _, layout := range matching {
+			if order != OrderUnknown && layout.Order == order {
+				return layout, nil
+			}
+		}
+
+		return Layout{}, fmt.Errorf("%w column matches %s", ErrAmbiguousDate, layoutNames(matching))
+	}
+
+	return matching[0], nil
+}
+
+func (o ParseAnyOptions) layouts() []Layout {
+	if len(o.Layouts) == 0 {
+		return DefaultLayouts
+	}
+
+	return o.Layouts
+}
+
+func (o ParseAnyOptions) order() DateOrder {
+	if o.Order != OrderUnknown || o.Locale == nil {
+		return o.Order
+	}
+
+	short := o.Locale.DateFormat(locale.StyleShort)
+
+	if strings.IndexByte(short, 'd') < strings.IndexByte(short, 'M') {
+		return OrderDayFirst
+	}
+
+	return OrderMonthFirst
+}
+
+func (m anyMatch) differs(matches []anyMatch) bool {
+	for _, other := range matches {
+		if !other.time.Equal(m.time) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func newParseAnyResult(m anyMatch, ambiguous bool, candidates []Layout) (*ParseAnyResult, error) {
+	t := m.time.UTC()
+	d, err := fromTime(t)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &ParseAnyResult{
+		DateTime:   d,
+		Layout:     m.layout,
+		Ambiguous:  ambiguous,
+		Candidates: candidates,
+	}, nil
+}
+
+func parsesAll(layout Layout, values []string) bool {
+	for _, value := range values {
+		value = strings.TrimSpace(value)
+
+		if value == "" {
+			continue
+		}
+
+		if _, err := ParseTime(layout.Pattern, value); err != nil {
+			return false
+		}
+	}
+
+	return true
+}
+
+func numericLayout(values []string, mode NumericMode) (Layout, bool) {
+	var layout Layout
+
+	for _, value := range values {
+		if value = strings.TrimSpace(value); value == "" {
+			continue
+		}
+
+		l, _, ok, err := parseNumeric(value, mode)
+
+		if !ok || err != nil || (layout.Name != "" && l.Name != layout.Name) {
+			return Layout{}, false
+		}
+
+		layout = l
+	}
+
+	return layout, layout.Name != ""
+}
+
+func layoutNames(layouts []Layout) string {
+	names := make([]string, len(layouts))
+
+	for i, layout := range layouts {
+		names[i] = fmt.Sprintf("\"%s\"", layout.Name)
+	}
+
+	return strings.Join(names, ", ")
+}
+
+// parseNumeric parses Unix seconds and Excel serials, ok is false when value is not a number
+func parseNumeric(value string, mode NumericMode) (Layout, time.Time, bool, error) {
+	if mode == NumericNone || value == "" {
+		return Layout{}, time.Time{}, false, nil
+	}
+
+	number, err := strconv.ParseFloat(value, 64)
+
+	if err != nil || strings.ContainsAny(value, "eEinfINFxX") {
+		return Layout{}, time.Time{}, false, nil
+	}
+
+	integerDigits := len(strings.TrimLeft(strings.SplitN(value, ".", 2)[0], "+-"))
+
+	switch {
+	case mode == NumericUnix, mode == NumericAuto && integerDigits >= 8 && !strings.Contains(value, "."):
+		return LayoutUnix, time.Unix(int64(number), 0).UTC(), true, nil
+	case mode == NumericExcel, mode == NumericAuto && integerDigits <= 5:
+		t, err := excelSerial(number)
+		return LayoutExcel, t, true, err
+	}
+
+	return Layout{}, time.Time{}, true, &ParseError{Input: value, Expected: "unix seconds or excel serial"}
+}
+
+// excelSerial converts serial of Excel 1900 date system, which counts nonexistent 1900-02-29 as day 60
+func excelSerial(serial float64) (time.Time, error) {
+	days := math.Floor(serial)
+
+	if days < 1 || days > 2958465 {
+		return time.Time{}, &FieldRangeError{Field: "excel serial", Value: int(math.Max(math.Min(days, math.MaxInt32), math.MinInt32)), Min: 1, Max: 2958465}
+	}
+
+	if days == 60 {
+		return time.Time{}, &ParseError{Input: strconv.FormatFloat(serial, 'f', -1, 64), Expected: "excel serial other than 60 (nonexistent 1900-02-29)"}
+	}
+
+	epoch := GetDate(1899, 12, 30)
+
+	if days < 60 {
+		epoch = GetDate(1899, 12, 31)
+	}
+
+	seconds := math.Round((serial - days) * 86400)
+
+	return epoch.AddDate(0, 0, int(days)).Add(time.Duration(seconds) * time.Second), nil
+}
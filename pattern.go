@@ -0,0 +1,547 @@
+package datetime
+
+import (
+	"container/list"
+	"github.com/gouef/datetime/locale"
+	"strings"
+	"sync"
+)
+
+// Dialect of format pattern
+type Dialect int
+
+const (
+	// DialectStrftime C strftime patterns like "%Y-%m-%d %H:%M:%S"
+	DialectStrftime Dialect = iota
+	// DialectPHP PHP date() patterns like "Y-m-d H:i:s"
+	DialectPHP
+	// DialectICU ICU/CLDR patterns like "yyyy-MM-dd HH:mm:ss"
+	DialectICU
+)
+
+// Pattern format layout written in some Dialect, names are taken from Locale (english if nil)
+type Pattern struct {
+	Dialect Dialect
+	Layout  string
+	Locale  *locale.Locale
+}
+
+// Strftime pattern in strftime dialect
+func Strftime(layout string) Pattern {
+	return Pattern{Dialect: DialectStrftime, Layout: layout}
+}
+
+// PHP pattern in PHP date() dialect
+func PHP(layout string) Pattern {
+	return Pattern{Dialect: DialectPHP, Layout: layout}
+}
+
+// ICU pattern in ICU/CLDR dialect
+func ICU(layout string) Pattern {
+	return Pattern{Dialect: DialectICU, Layout: layout}
+}
+
+// In returns pattern with names of locale
+func (p Pattern) In(l *locale.Locale) Pattern {
+	p.Locale = l
+	return p
+}
+
+// DateStyle predefined date pattern of locale
+func DateStyle(l *locale.Locale, style locale.Style) Pattern {
+	return ICU(l.DateFormat(style)).In(l)
+}
+
+// TimeStyle predefined time pattern of locale
+func TimeStyle(l *locale.Locale, style locale.Style) Pattern {
+	return ICU(l.TimeFormat(style)).In(l)
+}
+
+// DateTimeStyle predefined date and time pattern of locale
+func DateTimeStyle(l *locale.Locale, dateStyle, timeStyle locale.Style) Pattern {
+	return ICU(l.DateTimeFormat(dateStyle, timeStyle)).In(l)
+}
+
+func (p Pattern) locale() *locale.Locale {
+	if p.Locale == nil {
+		return locale.Default()
+	}
+
+	return p.Locale
+}
+
+type elementKind int
+
+const (
+	elementLiteral elementKind = iota
+	elementYear
+	elementYearShort
+	elementISOYear
+	elementMonth
+	elementMonthPadded
+	elementMonthShortName
+	elementMonthName
+	elementMonthStandaloneShortName
+	elementMonthStandaloneName
+	elementDay
+	elementDayPadded
+	elementDaySpacePadded
+	elementDayOrdinalSuffix
+	elementDayOfYear
+	elementDayOfYearPadded
+	elementDayOfYearZeroBased
+	elementWeekdayShortName
+	elementWeekdayName
+	elementWeekdayStandaloneShortName
+	elementWeekdayStandaloneName
+	elementWeekdayISO
+	elementWeekdayNumber
+	elementISOWeek
+	elementDaysInMonth
+	elementLeapYear
+	elementHour
+	elementHourPadded
+	elementHourSpacePadded
+	elementHour12
+	elementHour12Padded
+	elementHour12SpacePadded
+	elementMinute
+	elementMinutePadded
+	elementSecond
+	elementSecondPadded
+	elementFraction
+	elementAmPm
+	elementAmPmLower
+	elementZoneAbbreviation
+	elementZoneName
+	elementOffset
+	elementOffsetColon
+	elementOffsetZ
+	elementOffsetColonZ
+	elementUnix
+)
+
+type element struct {
+	kind    elementKind
+	literal string
+	// width digits of elementFraction
+	width int
+}
+
+type patternKey struct {
+	dialect Dialect
+	layout  string
+}
+
+// maxCompiledPatterns compiled patterns kept in cache, layouts can come from users, so the least recently used is dropped
+const maxCompiledPatterns = 256
+
+type compiledPattern struct {
+	key      patternKey
+	elements []element
+}
+
+// patternCache LRU cache of compiled patterns
+type patternCache struct {
+	mu sync.Mutex
+	// recent compiledPattern, the most recently used first
+	recent  *list.List
+	entries map[patternKey]*list.Element
+}
+
+var compiledPatterns = &patternCache{recent: list.New(), entries: map[patternKey]*list.Element{}}
+
+func (c *patternCache) load(key patternKey) ([]element, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry, ok := c.entries[key]
+
+	if !ok {
+		return nil, false
+	}
+
+	c.recent.MoveToFront(entry)
+
+	return entry.Value.(compiledPattern).elements, true
+}
+
+func (c *patternCache) store(key patternKey, elements []element) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if _, ok := c.entries[key]; ok {
+		return
+	}
+
+	c.entries[key] = c.recent.PushFront(compiledPattern{key: key, elements: elements})
+
+	if c.recent.Len() > maxCompiledPatterns {
+		oldest := c.recent.Remove(c.recent.Back()).(compiledPattern)
+		delete(c.entries, oldest.key)
+	}
+}
+
+func compile(pattern Pattern) []element {
+	key := patternKey{dialect: pattern.Dialect, layout: pattern.Layout}
+
+	if elements, ok := compiledPatterns.load(key); ok {
+		return elements
+	}
+
+	var elements []element
+
+	switch pattern.Dialect {
+	case DialectPHP:
+		elements = compilePHP(pattern.Layout)
+	case DialectICU:
+		elements = compileICU(pattern.Layout)
+	default:
+		elements = compileStrftime(pattern.Layout)
+	}
+
+	compiledPatterns.store(key, elements)
+
+	return elements
+}
+
+func appendLiteral(elements []element, literal string) []element {
+	if n := len(elements); n > 0 && elements[n-1].kind == elementLiteral {
+		elements[n-1].literal += literal
+		return elements
+	}
+
+	return append(elements, element{kind: elementLiteral, literal: literal})
+}
+
+func appendElements(elements []element, more []element) []element {
+	for _, e := range more {
+		if e.kind == elementLiteral {
+			elements = appendLiteral(elements, e.literal)
+		} else {
+			elements = append(elements, e)
+		}
+	}
+
+	return elements
+}
+
+var strftimeElements = map[byte]elementKind{
+	'a': elementWeekdayShortName,
+	'A': elementWeekdayName,
+	'b': elementMonthShortName,
+	'h': elementMonthShortName,
+	'B': elementMonthName,
+	'd': elementDayPadded,
+	'e': elementDaySpacePadded,
+	'j': elementDayOfYearPadded,
+	'm': elementMonthPadded,
+	'y': elementYearShort,
+	'Y': elementYear,
+	'G': elementISOYear,
+	'V': elementISOWeek,
+	'u': elementWeekdayISO,
+	'w': elementWeekdayNumber,
+	'H': elementHourPadded,
+	'k': elementHourSpacePadded,
+	'I': elementHour12Padded,
+	'l': elementHour12SpacePadded,
+	'M': elementMinutePadded,
+	'S': elementSecondPadded,
+	'p': elementAmPm,
+	'P': elementAmPmLower,
+	'z': elementOffset,
+	'Z': elementZoneAbbreviation,
+	's': elementUnix,
+}
+
+var strftimeUnpadded = map[byte]elementKind{
+	'd': elementDay,
+	'e': elementDay,
+	'j': elementDayOfYear,
+	'm': elementMonth,
+	'H': elementHour,
+	'k': elementHour,
+	'I': elementHour12,
+	'l': elementHour12,
+	'M': elementMinute,
+	'S': elementSecond,
+}
+
+var strftimeStandalone = map[byte]elementKind{
+	'b': elementMonthStandaloneShortName,
+	'h': elementMonthStandaloneShortName,
+	'B': elementMonthStandaloneName,
+}
+
+var strftimeComposites = map[byte]string{
+	'c': "%a %b %e %H:%M:%S %Y",
+	'D': "%m/%d/%y",
+	'F': "%Y-%m-%d",
+	'R': "%H:%M",
+	'T': "%H:%M:%S",
+	'x': "%m/%d/%y",
+	'X': "%H:%M:%S",
+}
+
+func compileStrftime(layout string) []element {
+	var elements []element
+
+	for i := 0; i < len(layout); i++ {
+		c := layout[i]
+
+		if c != '%' || i+1 >= len(layout) {
+			elements = appendLiteral(elements, string(c))
+			continue
+		}
+
+		i++
+		c = layout[i]
+
+		if c == '-' && i+1 < len(layout) {
+			if kind, ok := strftimeUnpadded[layout[i+1]]; ok {
+				i++
+				elements = append(elements, element{kind: kind})
+				continue
+			}
+		}
+
+		if c == 'O' && i+1 < len(layout) {
+			if kind, ok := strftimeStandalone[layout[i+1]]; ok {
+				i++
+				elements = append(elements, element{kind: kind})
+				continue
+			}
+		}
+
+		switch c {
+		case 'N':
+			elements = append(elements, element{kind: elementFraction, width: 9})
+		case '%':
+			elements = appendLiteral(elements, "%")
+		case 'n':
+			elements = appendLiteral(elements, "\n")
+		case 't':
+			elements = appendLiteral(elements, "\t")
+		default:
+			if composite, ok := strftimeComposites[c]; ok {
+				elements = appendElements(elements, compileStrftime(composite))
+			} else if kind, ok := strftimeElements[c]; ok {
+				elements = append(elements, element{kind: kind})
+			} else {
+				elements = appendLiteral(elements, "%"+string(c))
+			}
+		}
+	}
+
+	return elements
+}
+
+var phpElements = map[byte]elementKind{
+	'd': elementDayPadded,
+	'D': elementWeekdayShortName,
+	'j': elementDay,
+	'l': elementWeekdayName,
+	'N': elementWeekdayISO,
+	'S': elementDayOrdinalSuffix,
+	'w': elementWeekdayNumber,
+	'z': elementDayOfYearZeroBased,
+	'W': elementISOWeek,
+	'F': elementMonthName,
+	'm': elementMonthPadded,
+	'M': elementMonthShortName,
+	'n': elementMonth,
+	't': elementDaysInMonth,
+	'L': elementLeapYear,
+	'o': elementISOYear,
+	'Y': elementYear,
+	'y': elementYearShort,
+	'a': elementAmPmLower,
+	'A': elementAmPm,
+	'g': elementHour12,
+	'G': elementHour,
+	'h': elementHour12Padded,
+	'H': elementHourPadded,
+	'i': elementMinutePadded,
+	's': elementSecondPadded,
+	'e': elementZoneName,
+	'T': elementZoneAbbreviation,
+	'O': elementOffset,
+	'P': elementOffsetColon,
+	'p': elementOffsetColonZ,
+	'U': elementUnix,
+}
+
+// phpFractions digits of fraction of second, "v" milliseconds, "u" microseconds
+var phpFractions = map[byte]int{
+	'v': 3,
+	'u': 6,
+}
+
+var phpComposites = map[byte]string{
+	'c': `Y-m-d\TH:i:sP`,
+	'r': `D, d M Y H:i:s O`,
+}
+
+func compilePHP(layout string) []element {
+	var elements []element
+
+	for i := 0; i < len(layout); i++ {
+		c := layout[i]
+
+		if c == '\\' {
+			if i+1 < len(layout) {
+				i++
+				elements = appendLiteral(elements, string(layout[i]))
+			}
+			continue
+		}
+
+		if composite, ok := phpComposites[c]; ok {
+			elements = appendElements(elements, compilePHP(composite))
+		} else if kind, ok := phpElements[c]; ok {
+			elements = append(elements, element{kind: kind})
+		} else if width, ok := phpFractions[c]; ok {
+			elements = append(elements, element{kind: elementFraction, width: width})
+		} else {
+			elements = appendLiteral(elements, string(c))
+		}
+	}
+
+	return elements
+}
+
+func compileICU(layout string) []element {
+	var elements []element
+
+	for i := 0; i < len(layout); {
+		c := layout[i]
+
+		if c == '\'' {
+			if i+1 < len(layout) && layout[i+1] == '\'' {
+				elements = appendLiteral(elements, "'")
+				i += 2
+				continue
+			}
+
+			var quoted strings.Builder
+
+			for i++; i < len(layout); i++ {
+				if layout[i] != '\'' {
+					quoted.WriteByte(layout[i])
+					continue
+				}
+
+				if i+1 < len(layout) && layout[i+1] == '\'' {
+					quoted.WriteByte('\'')
+					i++
+					continue
+				}
+
+				i++
+				break
+			}
+
+			elements = appendLiteral(elements, quoted.String())
+			continue
+		}
+
+		if !isASCIILetter(c) {
+			elements = appendLiteral(elements, string(c))
+			i++
+			continue
+		}
+
+		count := 1
+		for i+count < len(layout) && layout[i+count] == c {
+			count++
+		}
+
+		if kind, ok := icuElement(c, count); ok {
+			elements = append(elements, element{kind: kind, width: min(count, 9)})
+		} else {
+			elements = appendLiteral(elements, layout[i:i+count])
+		}
+
+		i += count
+	}
+
+	return elements
+}
+
+func icuElement(letter byte, count int) (elementKind, bool) {
+	switch letter {
+	case 'y', 'u':
+		if count == 2 {
+			return elementYearShort, true
+		}
+		return elementYear, true
+	case 'Y':
+		return elementISOYear, true
+	case 'M':
+		return pick(count, elementMonth, elementMonthPadded, elementMonthShortName, elementMonthName), true
+	case 'L':
+		return pick(count, elementMonth, elementMonthPadded, elementMonthStandaloneShortName, elementMonthStandaloneName), true
+	case 'd':
+		return pick(count, elementDay, elementDayPadded), true
+	case 'D':
+		if count == 1 {
+			return elementDayOfYear, true
+		}
+		return elementDayOfYearPadded, true
+	case 'E':
+		if count >= 4 {
+			return elementWeekdayName, true
+		}
+		return elementWeekdayShortName, true
+	case 'c':
+		return pick(count, elementWeekdayNumber, elementWeekdayNumber, elementWeekdayStandaloneShortName, elementWeekdayStandaloneName), true
+	case 'w':
+		return elementISOWeek, true
+	case 'a':
+		return elementAmPm, true
+	case 'H':
+		return pick(count, elementHour, elementHourPadded), true
+	case 'h':
+		return pick(count, elementHour12, elementHour12Padded), true
+	case 'm':
+		return pick(count, elementMinute, elementMinutePadded), true
+	case 's':
+		return pick(count, elementSecond, elementSecondPadded), true
+	case 'S':
+		return elementFraction, true
+	case 'z':
+		return elementZoneAbbreviation, true
+	case 'V':
+		return elementZoneName, true
+	case 'Z':
+		if count >= 5 {
+			return elementOffsetColonZ, true
+		}
+		return elementOffset, true
+	case 'X':
+		if count >= 3 {
+			return elementOffsetColonZ, true
+		}
+		return elementOffsetZ, true
+	case 'x':
+		if count >= 3 {
+			return elementOffsetColon, true
+		}
+		return elementOffset, true
+	}
+
+	return elementLiteral, false
+}
+
+func pick(count int, kinds ...elementKind) elementKind {
+	if count > len(kinds) {
+		return kinds[len(kinds)-1]
+	}
+
+	return kinds[count-1]
+}
+
+func isASCIILetter(c byte) bool {
+	return c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z'
+}
@@ -0,0 +1,182 @@
+package datetime
+
+import (
+	"time"
+)
+
+// StartOfDay returns midnight of day of t in location of t
+func StartOfDay(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+}
+
+// EndOfDay returns the last nanosecond of day of t
+func EndOfDay(t time.Time) time.Time {
+	return StartOfDay(t).AddDate(0, 0, 1).Add(-time.Nanosecond)
+}
+
+// StartOfWeek returns midnight of the first day of week of t, week starts on weekStart
+func StartOfWeek(t time.Time, weekStart time.Weekday) time.Time {
+	return StartOfDay(t).AddDate(0, 0, -daysUntil(weekStart, t.Weekday(), false))
+}
+
+// EndOfWeek returns the last nanosecond of week of t, week starts on weekStart
+func EndOfWeek(t time.Time, weekStart time.Weekday) time.Time {
+	return StartOfWeek(t, weekStart).AddDate(0, 0, 7).Add(-time.Nanosecond)
+}
+
+// StartOfMonth returns midnight of the first day of month of t
+func StartOfMonth(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, t.Location())
+}
+
+// EndOfMonth returns the last nanosecond of month of t
+func EndOfMonth(t time.Time) time.Time {
+	return StartOfMonth(t).AddDate(0, 1, 0).Add(-time.Nanosecond)
+}
+
+// StartOfQuarter returns midnight of the first day of quarter of t
+func StartOfQuarter(t time.Time) time.Time {
+	return time.Date(t.Year(), (t.Month()-1)/3*3+1, 1, 0, 0, 0, 0, t.Location())
+}
+
+// EndOfQuarter returns the last nanosecond of quarter of t
+func EndOfQuarter(t time.Time) time.Time {
+	return StartOfQuarter(t).AddDate(0, 3, 0).Add(-time.Nanosecond)
+}
+
+// StartOfYear returns midnight of January 1 of year of t
+func StartOfYear(t time.Time) time.Time {
+	return time.Date(t.Year(), 1, 1, 0, 0, 0, 0, t.Location())
+}
+
+// EndOfYear returns the last nanosecond of year of t
+func EndOfYear(t time.Time) time.Time {
+	return StartOfYear(t).AddDate(1, 0, 0).Add(-time.Nanosecond)
+}
+
+// FloorToStep returns t rounded down to a multiple of step counted from midnight,
+// e.g. 10:14 is 10:00 for 15 minutes, step longer than day floors to midnight
+func FloorToStep(t time.Time, step time.Duration) time.Time {
+	if step <= 0 {
+		return t
+	}
+
+	start := StartOfDay(t)
+	elapsed := t.Sub(start)
+
+	return start.Add(elapsed - elapsed%step)
+}
+
+// CeilToStep returns t rounded up to a multiple of step counted from midnight, e.g. 10:01 is 10:15 for 15 minutes
+func CeilToStep(t time.Time, step time.Duration) time.Time {
+	floor := FloorToStep(t, step)
+
+	if floor.Equal(t) {
+		return t
+	}
+
+	return floor.Add(step)
+}
+
+// RoundToStep returns t rounded to the nearest multiple of step counted from midnight, halfway values are rounded up,
+// e.g. 10:07:30 is 10:15 for 15 minutes
+func RoundToStep(t time.Time, step time.Duration) time.Time {
+	floor := FloorToStep(t, step)
+
+	if step > 0 && t.Sub(floor) >= step-t.Sub(floor) {
+		return floor.Add(step)
+	}
+
+	return floor
+}
+
+func (d DateTime) StartOfDay() DateTime {
+	return DateTime{dateTime: StartOfDay(d.dateTime)}
+}
+
+func (d DateTime) EndOfDay() DateTime {
+	return DateTime{dateTime: EndOfDay(d.dateTime)}
+}
+
+// StartOfWeek returns start of week of d, week starts on weekStart, e.g. time.Monday
+func (d DateTime) StartOfWeek(weekStart time.Weekday) DateTime {
+	return DateTime{dateTime: StartOfWeek(d.dateTime, weekStart)}
+}
+
+// EndOfWeek returns end of week of d, week starts on weekStart, e.g. time.Monday
+func (d DateTime) EndOfWeek(weekStart time.Weekday) DateTime {
+	return DateTime{dateTime: EndOfWeek(d.dateTime, weekStart)}
+}
+
+func (d DateTime) StartOfMonth() DateTime {
+	return DateTime{dateTime: StartOfMonth(d.dateTime)}
+}
+
+func (d DateTime) EndOfMonth() DateTime {
+	return DateTime{dateTime: EndOfMonth(d.dateTime)}
+}
+
+func (d DateTime) StartOfQuarter() DateTime {
+	return DateTime{dateTime: StartOfQuarter(d.dateTime)}
+}
+
+func (d DateTime) EndOfQuarter() DateTime {
+	return DateTime{dateTime: EndOfQuarter(d.dateTime)}
+}
+
+func (d DateTime) StartOfYear() DateTime {
+	return DateTime{dateTime: StartOfYear(d.dateTime)}
+}
+
+func (d DateTime) EndOfYear() DateTime {
+	return DateTime{dateTime: EndOfYear(d.dateTime)}
+}
+
+// FloorToStep returns d rounded down to a multiple of step counted from midnight, see FloorToStep
+func (d DateTime) FloorToStep(step time.Duration) DateTime {
+	return DateTime{dateTime: FloorToStep(d.dateTime, step)}
+}
+
+// CeilToStep returns d rounded up to a multiple of step counted from midnight, see CeilToStep
+func (d DateTime) CeilToStep(step time.Duration) DateTime {
+	return DateTime{dateTime: CeilToStep(d.dateTime, step)}
+}
+
+// RoundToStep returns d rounded to the nearest multiple of step counted from midnight, see RoundToStep
+func (d DateTime) RoundToStep(step time.Duration) DateTime {
+	return DateTime{dateTime: RoundToStep(d.dateTime, step)}
+}
+
+// DayRange returns range "[start, end]" of day of d
+func (d DateTime) DayRange() *Range {
+	return periodRange(d.StartOfDay(), d.EndOfDay())
+}
+
+// WeekRange returns range "[start, end]" of week of d, week starts on weekStart
+func (d DateTime) WeekRange(weekStart time.Weekday) *Range {
+	return periodRange(d.StartOfWeek(weekStart), d.EndOfWeek(weekStart))
+}
+
+// MonthRange returns range "[start, end]" of month of d
+func (d DateTime) MonthRange() *Range {
+	return periodRange(d.StartOfMonth(), d.EndOfMonth())
+}
+
+// QuarterRange returns range "[start, end]" of quarter of d
+func (d DateTime) QuarterRange() *Range {
+	return periodRange(d.StartOfQuarter(), d.EndOfQuarter())
+}
+
+// YearRange returns range "[start, end]" of year of d
+func (d DateTime) YearRange() *Range {
+	return periodRange(d.StartOfYear(), d.EndOfYear())
+}
+
+func periodRange(start, end DateTime) *Range {
+	return &Range{
+		from:  Value(start.ToString()),
+		to:    Value(end.ToString()),
+		start: RangeStartStrict,
+		end:   RangeEndStrict,
+	}
+}
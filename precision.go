@@ -0,0 +1,25 @@
+package datetime
+
+import (
+	"strings"
+)
+
+// Precision number of digits of fraction of second, 0-9
+type Precision int
+
+const (
+	PrecisionSecond      Precision = 0
+	PrecisionMillisecond Precision = 3
+	PrecisionMicrosecond Precision = 6
+	PrecisionNanosecond  Precision = 9
+)
+
+// Layout returns layout of fraction for time.Format, e.g. ".000" for PrecisionMillisecond,
+// empty for PrecisionSecond, digits after precision are truncated
+func (p Precision) Layout() string {
+	if p <= 0 {
+		return ""
+	}
+
+	return "." + strings.Repeat("0", min(int(p), 9))
+}
@@ -1,10 +1,7 @@
 package datetime
 
 import (
-	"errors"
 	"fmt"
-	"github.com/gouef/validator"
-	"github.com/gouef/validator/constraints"
 	"regexp"
 	"time"
 )
@@ -18,13 +15,13 @@ type Range struct {
 
 func NewRange(from, to string, start RangeStart, end RangeEnd) (*Range, error) {
 
-	_, err := FromString(from)
+	_, err := ParseString(from)
 
 	if from != "" && err != nil {
 		return nil, err
 	}
 
-	_, err = FromString(to)
+	_, err = ParseString(to)
 
 	if to != "" && err != nil {
 		return nil, err
@@ -54,15 +51,15 @@ func NewRangeStartOptional(from, to string) (*Range, error) {
 	return NewRange(from, to, RangeStartOptional, RangeEndStrict)
 }
 
+var rangeRegexp = regexp.MustCompile(RangeRegexp)
+
 func RangeFromString(value string) (*Range, error) {
-	errs := validator.Validate(value, constraints.RegularExpression{Regexp: RangeRegexp})
+	match := rangeRegexp.FindStringSubmatch(value)
 
-	if len(errs) != 0 {
-		return nil, errors.New(fmt.Sprintf("unsupported format of datetime range \"%s\"", value))
+	if match == nil {
+		return nil, &ParseError{Input: value, Expected: "date time range \"[2006-01-02 15:04:05, 2006-01-02 15:04:05]\""}
 	}
 
-	re := regexp.MustCompile(RangeRegexp)
-	match := re.FindStringSubmatch(value)
 	start := match[1]
 	from := match[2]
 	to := match[12]
@@ -119,12 +116,14 @@ func (r *Range) Is(value any) bool {
 func (r *Range) format(date any) (Interface, error) {
 	switch i := date.(type) {
 	case time.Time:
-		return New(i.Year(), int(i.Month()), i.Day(), i.Hour(), i.Minute(), i.Second())
+		return fromTime(i)
 	case *DateTime:
 		return i, nil
+	case DateTime:
+		return &i, nil
 	case string:
 		return FromString(i)
 	default:
-		return nil, errors.New("unsupported format of datetime")
+		return nil, fmt.Errorf("%w %T of date time", ErrUnsupportedType, date)
 	}
 }
@@ -24,8 +24,9 @@ const (
 	HourRegexp     = `(0[0-9]|1[0-9]|2[0-3])`
 	MinuteRegexp   = `[0-5][0-9]`
 	SecondRegexp   = `[0-5][0-9]`
+	FractionRegexp = `(?:\.\d{1,9})?`
 	DateRegexp     = YearRegexp + `-` + MonthRegexp + `-` + DayRegexp
-	TimeRegexp     = `(` + HourRegexp + `):(` + MinuteRegexp + `):(` + SecondRegexp + `)`
+	TimeRegexp     = `(` + HourRegexp + `):(` + MinuteRegexp + `):(` + SecondRegexp + `)` + FractionRegexp
 	DateTimeRegexp = `((` + DateRegexp + `) (` + TimeRegexp + `))`
 	RangeRegexp    = `^([\[\(])` + DateTimeRegexp + `?\s*,\s*` + DateTimeRegexp + `?([\]\)])$`
 )
@@ -0,0 +1,611 @@
+package datetime
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
+)
+
+var relativeWeekdays = map[string]time.Weekday{
+	"sunday": time.Sunday, "sun": time.Sunday,
+	"monday": time.Monday, "mon": time.Monday,
+	"tuesday": time.Tuesday, "tue": time.Tuesday,
+	"wednesday": time.Wednesday, "wed": time.Wednesday,
+	"thursday": time.Thursday, "thu": time.Thursday,
+	"friday": time.Friday, "fri": time.Friday,
+	"saturday": time.Saturday, "sat": time.Saturday,
+}
+
+var relativeMonths = map[string]time.Month{
+	"january": time.January, "jan": time.January,
+	"february": time.February, "feb": time.February,
+	"march": time.March, "mar": time.March,
+	"april": time.April, "apr": time.April,
+	"may":  time.May,
+	"june": time.June, "jun": time.June,
+	"july": time.July, "jul": time.July,
+	"august": time.August, "aug": time.August,
+	"september": time.September, "sep": time.September,
+	"october": time.October, "oct": time.October,
+	"november": time.November, "nov": time.November,
+	"december": time.December, "dec": time.December,
+}
+
+var relativeOrdinals = map[string]int{
+	"first": 1, "1st": 1,
+	"second": 2, "2nd": 2,
+	"third": 3, "3rd": 3,
+	"fourth": 4, "4th": 4,
+	"fifth": 5, "5th": 5,
+	"last": -1,
+}
+
+type relativeUnit string
+
+const (
+	relativeSecond      relativeUnit = "second"
+	relativeMinute      relativeUnit = "minute"
+	relativeHour        relativeUnit = "hour"
+	relativeDay         relativeUnit = "day"
+	relativeBusinessDay relativeUnit = "business day"
+	relativeWeek        relativeUnit = "week"
+	relativeMonth       relativeUnit = "month"
+	relativeQuarter     relativeUnit = "quarter"
+	relativeYear        relativeUnit = "year"
+)
+
+var relativeUnits = map[string]relativeUnit{
+	"second": relativeSecond, "seconds": relativeSecond, "sec": relativeSecond, "secs": relativeSecond,
+	"minute": relativeMinute, "minutes": relativeMinute, "min": relativeMinute, "mins": relativeMinute,
+	"hour": relativeHour, "hours": relativeHour,
+	"day": relativeDay, "days": relativeDay,
+	"week": relativeWeek, "weeks": relativeWeek,
+	"month": relativeMonth, "months": relativeMonth,
+	"quarter": relativeQuarter, "quarters": relativeQuarter,
+	"year": relativeYear, "years": relativeYear,
+}
+
+// ParseRelative resolves expressions like "tomorrow 14:00", "next monday", "+3 days",
+// "last day of next month", "first friday of march" or "end of quarter" against reference (nil is Now)
+func ParseRelative(expression string, reference *DateTime) (*DateTime, error) {
+	return ParseRelativeWithCalendar(expression, reference, nil)
+}
+
+// ParseRelativeWithCalendar resolves expression like ParseRelative, business days
+// ("+2 business days", "last business day of month") are taken from calendar, nil means WeekendCalendar
+func ParseRelativeWithCalendar(expression string, reference *DateTime, calendar BusinessCalendar) (*DateTime, error) {
+	if calendar == nil {
+		calendar = WeekendCalendar()
+	}
+
+	if reference == nil {
+		reference = Now()
+	}
+
+	tokens, offsets := tokenizeRelative(expression)
+	p := &relativeParser{
+		input:     expression,
+		tokens:    tokens,
+		offsets:   offsets,
+		reference: reference.Time(),
+		calendar:  calendar,
+	}
+
+	t, err := p.parse()
+
+	if err != nil {
+		return nil, err
+	}
+
+	return fromTime(t)
+}
+
+// tokenizeRelative splits expression into lower case tokens and their byte offsets
+func tokenizeRelative(expression string) ([]string, []int) {
+	var tokens []string
+	var offsets []int
+
+	for i := 0; i < len(expression); {
+		if r, width := utf8.DecodeRuneInString(expression[i:]); unicode.IsSpace(r) {
+			i += width
+			continue
+		}
+
+		start := i
+
+		for i < len(expression) {
+			r, width := utf8.DecodeRuneInString(expression[i:])
+
+			if unicode.IsSpace(r) {
+				break
+			}
+
+			i += width
+		}
+
+		field := strings.TrimSuffix(strings.ToLower(expression[start:i]), ",")
+
+		if len(field) > 1 && (field[0] == '+' || field[0] == '-') {
+			tokens = append(tokens, field[:1], field[1:])
+			offsets = append(offsets, start, start+1)
+			continue
+		}
+
+		if field != "" {
+			tokens = append(tokens, field)
+			offsets = append(offsets, start)
+		}
+	}
+
+	return tokens, offsets
+}
+
+type relativeParser struct {
+	input     string
+	tokens    []string
+	offsets   []int
+	pos       int
+	reference time.Time
+	calendar  BusinessCalendar
+}
+
+func (p *relativeParser) peek(offset int) string {
+	if p.pos+offset < len(p.tokens) {
+		return p.tokens[p.pos+offset]
+	}
+
+	return ""
+}
+
+func (p *relativeParser) next() string {
+	token := p.peek(0)
+	p.pos++
+
+	return token
+}
+
+func (p *relativeParser) accept(tokens ...string) bool {
+	for i, token := range tokens {
+		if p.peek(i) != token {
+			return false
+		}
+	}
+
+	p.pos += len(tokens)
+
+	return true
+}
+
+func (p *relativeParser) expect(token string) error {
+	if !p.accept(token) {
+		return p.expected(fmt.Sprintf("\"%s\"", token))
+	}
+
+	return nil
+}
+
+// expected returns *ParseError at current token
+func (p *relativeParser) expected(what string) error {
+	position := len(p.input)
+
+	if p.pos < len(p.offsets) {
+		position = p.offsets[p.pos]
+	}
+
+	return &ParseError{Input: p.input, Position: position, Expected: what}
+}
+
+func (p *relativeParser) done() bool {
+	return p.pos >= len(p.tokens)
+}
+
+func (p *relativeParser) parse() (time.Time, error) {
+	if p.done() {
+		return time.Time{}, p.expected("relative expression")
+	}
+
+	t, err := p.parseDate()
+
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	for !p.done() {
+		p.accept("at")
+
+		if hour, minute, second, ok := p.parseClock(); ok {
+			t = time.Date(t.Year(), t.Month(), t.Day(), hour, minute, second, 0, time.UTC)
+			continue
+		}
+
+		t, err = p.parseShift(t)
+
+		if err != nil {
+			return time.Time{}, err
+		}
+	}
+
+	return t, nil
+}
+
+func (p *relativeParser) parseDate() (time.Time, error) {
+	today := StartOfDay(p.reference)
+	token := p.peek(0)
+
+	switch {
+	case p.accept("now"):
+		return p.reference, nil
+	case p.accept("today"):
+		return today, nil
+	case p.accept("tomorrow"):
+		return today.AddDate(0, 0, 1), nil
+	case p.accept("yesterday"):
+		return today.AddDate(0, 0, -1), nil
+	case p.accept("day", "after", "tomorrow"):
+		return today.AddDate(0, 0, 2), nil
+	case p.accept("day", "before", "yesterday"):
+		return today.AddDate(0, 0, -2), nil
+	case p.accept("start", "of"), p.accept("beginning", "of"):
+		start, _, err := p.parsePeriod()
+		return start, err
+	case p.accept("end", "of"):
+		_, end, err := p.parsePeriod()
+		return end, err
+	case token == "next" || token == "last" || token == "previous" || token == "this":
+		if p.peek(2) != "of" && p.peek(3) != "of" {
+			return p.parseNextLast()
+		}
+		return p.parseOrdinal()
+	case relativeOrdinals[token] != 0:
+		return p.parseOrdinal()
+	}
+
+	if t, err := time.Parse(time.DateOnly, token); err == nil {
+		p.pos++
+		return t, nil
+	}
+
+	if _, _, _, ok := p.clone().parseClock(); ok {
+		return today, nil
+	}
+
+	return p.parseShift(p.reference)
+}
+
+func (p *relativeParser) clone() *relativeParser {
+	c := *p
+	return &c
+}
+
+// parseShift parses "+3 days", "in 2 weeks", "3 hours ago" and "2 business days from now"
+func (p *relativeParser) parseShift(t time.Time) (time.Time, error) {
+	sign := 1
+
+	switch {
+	case p.accept("+"), p.accept("in"):
+	case p.accept("-"):
+		sign = -1
+	}
+
+	n, err := strconv.Atoi(p.peek(0))
+
+	if err != nil {
+		if p.peek(0) != "a" && p.peek(0) != "an" {
+			return time.Time{}, p.expected("number")
+		}
+		n = 1
+	}
+
+	p.pos++
+	unit, err := p.parseUnit()
+
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	if p.accept("ago") {
+		sign = -sign
+	} else if !p.accept("from", "now") {
+		p.accept("later")
+	}
+
+	return p.add(t, unit, sign*n)
+}
+
+func (p *relativeParser) parseUnit() (relativeUnit, error) {
+	if p.accept("business", "day") || p.accept("business", "days") || p.accept("working", "day") || p.accept("working", "days") {
+		return relativeBusinessDay, nil
+	}
+
+	unit, ok := relativeUnits[p.peek(0)]
+
+	if !ok {
+		return "", p.expected("unit")
+	}
+
+	p.pos++
+
+	return unit, nil
+}
+
+func (p *relativeParser) add(t time.Time, unit relativeUnit, n int) (time.Time, error) {
+	switch unit {
+	case relativeSecond:
+		return t.Add(time.Duration(n) * time.Second), nil
+	case relativeMinute:
+		return t.Add(time.Duration(n) * time.Minute), nil
+	case relativeHour:
+		return t.Add(time.Duration(n) * time.Hour), nil
+	case relativeDay:
+		return t.AddDate(0, 0, n), nil
+	case relativeBusinessDay:
+		return AddBusinessDays(t, n, p.calendar)
+	case relativeWeek:
+		return t.AddDate(0, 0, 7*n), nil
+	case relativeMonth:
+		return addMonths(t, n), nil
+	case relativeQuarter:
+		return addMonths(t, 3*n), nil
+	}
+
+	return addMonths(t, 12*n), nil
+}
+
+// parseNextLast parses "next monday", "last friday", "this sunday", "next month" and "next business day"
+func (p *relativeParser) parseNextLast() (time.Time, error) {
+	direction := 1
+
+	switch p.next() {
+	case "last", "previous":
+		direction = -1
+	case "this":
+		direction = 0
+	}
+
+	today := StartOfDay(p.reference)
+
+	if weekday, ok := relativeWeekdays[p.peek(0)]; ok {
+		p.pos++
+
+		switch direction {
+		case 1:
+			return today.AddDate(0, 0, daysUntil(today.Weekday(), weekday, true)), nil
+		case -1:
+			return today.AddDate(0, 0, -daysUntil(weekday, today.Weekday(), true)), nil
+		}
+
+		return StartOfWeek(today, time.Monday).AddDate(0, 0, (int(weekday)+6)%7), nil
+	}
+
+	unit, err := p.parseUnit()
+
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	if unit == relativeBusinessDay {
+		if direction == 0 {
+			return AddBusinessDays(today.AddDate(0, 0, -1), 1, p.calendar)
+		}
+		return AddBusinessDays(today, direction, p.calendar)
+	}
+
+	return p.add(p.reference, unit, direction)
+}
+
+// parseOrdinal parses "first day of ...", "last friday of ...", "second business day of ..."
+func (p *relativeParser) parseOrdinal() (time.Time, error) {
+	n, ok := relativeOrdinals[p.peek(0)]
+
+	if !ok {
+		return time.Time{}, p.expected("ordinal")
+	}
+
+	p.pos++
+
+	weekday, isWeekday := relativeWeekdays[p.peek(0)]
+	isBusinessDay := false
+
+	switch {
+	case isWeekday:
+		p.pos++
+	case p.accept("business", "day"), p.accept("working", "day"):
+		isBusinessDay = true
+	case p.accept("day"):
+	default:
+		return time.Time{}, p.expected("day, weekday or business day")
+	}
+
+	if err := p.expect("of"); err != nil {
+		return time.Time{}, err
+	}
+
+	start, end, err := p.parsePeriod()
+
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	matches := func(t time.Time) bool {
+		switch {
+		case isWeekday:
+			return t.Weekday() == weekday
+		case isBusinessDay:
+			return p.calendar.IsBusinessDay(t)
+		}
+		return true
+	}
+
+	if n > 0 {
+		for t := start; !t.After(end); t = t.AddDate(0, 0, 1) {
+			if matches(t) {
+				if n--; n == 0 {
+					return t, nil
+				}
+			}
+		}
+	} else {
+		for t := StartOfDay(end); !t.Before(start); t = t.AddDate(0, 0, -1) {
+			if matches(t) {
+				return t, nil
+			}
+		}
+	}
+
+	return time.Time{}, p.expected("period containing the day")
+}
+
+// parsePeriod parses "month", "next month", "last quarter", "this year", "march", "march 2027" or "2027"
+// and returns its start and the last nanosecond like EndOfMonth
+func (p *relativeParser) parsePeriod() (time.Time, time.Time, error) {
+	direction := 0
+
+	switch {
+	case p.accept("next"):
+		direction = 1
+	case p.accept("last"), p.accept("previous"):
+		direction = -1
+	case p.accept("this"):
+	}
+
+	if month, ok := relativeMonths[p.peek(0)]; ok && direction == 0 {
+		p.pos++
+		year := p.reference.Year()
+
+		if y, err := strconv.Atoi(p.peek(0)); err == nil {
+			year = y
+			p.pos++
+		}
+
+		start := GetDate(year, int(month), 1)
+
+		return start, EndOfMonth(start), nil
+	}
+
+	if year, err := strconv.Atoi(p.peek(0)); err == nil && direction == 0 {
+		p.pos++
+		start := GetDate(year, 1, 1)
+
+		return start, EndOfYear(start), nil
+	}
+
+	unit, ok := relativeUnits[p.peek(0)]
+
+	if !ok {
+		return time.Time{}, time.Time{}, p.expected("period")
+	}
+
+	p.pos++
+	today := StartOfDay(p.reference)
+
+	switch unit {
+	case relativeDay:
+		start := today.AddDate(0, 0, direction)
+		return start, EndOfDay(start), nil
+	case relativeWeek:
+		start := StartOfWeek(today, time.Monday).AddDate(0, 0, 7*direction)
+		return start, EndOfWeek(start, time.Monday), nil
+	case relativeMonth:
+		start := StartOfMonth(today).AddDate(0, direction, 0)
+		return start, EndOfMonth(start), nil
+	case relativeQuarter:
+		start := StartOfQuarter(today).AddDate(0, 3*direction, 0)
+		return start, EndOfQuarter(start), nil
+	case relativeYear:
+		start := StartOfYear(today).AddDate(direction, 0, 0)
+		return start, EndOfYear(start), nil
+	}
+
+	return time.Time{}, time.Time{}, p.expected("period")
+}
+
+// parseClock parses "14:00", "14:00:30", "2pm", "2:30 pm", "noon" and "midnight"
+func (p *relativeParser) parseClock() (int, int, int, bool) {
+	token := p.peek(0)
+
+	switch token {
+	case "noon":
+		p.pos++
+		return 12, 0, 0, true
+	case "midnight":
+		p.pos++
+		return 0, 0, 0, true
+	}
+
+	suffix := ""
+
+	for _, s := range []string{"am", "pm"} {
+		if strings.HasSuffix(token, s) {
+			token, suffix = strings.TrimSuffix(token, s), s
+		}
+	}
+
+	if suffix == "" && (p.peek(1) == "am" || p.peek(1) == "pm") {
+		suffix = p.peek(1)
+	}
+
+	parts := strings.Split(token, ":")
+
+	if len(parts) > 3 || (len(parts) == 1 && suffix == "") {
+		return 0, 0, 0, false
+	}
+
+	values := []int{0, 0, 0}
+
+	for i, part := range parts {
+		value, err := strconv.Atoi(part)
+
+		if err != nil || (i > 0 && len(part) != 2) {
+			return 0, 0, 0, false
+		}
+
+		values[i] = value
+	}
+
+	hour, minute, second := values[0], values[1], values[2]
+
+	if suffix != "" {
+		if hour < 1 || hour > 12 {
+			return 0, 0, 0, false
+		}
+
+		hour %= 12
+
+		if suffix == "pm" {
+			hour += 12
+		}
+	}
+
+	if hour > 23 || minute > 59 || second > 59 {
+		return 0, 0, 0, false
+	}
+
+	p.pos++
+
+	if suffix != "" && p.peek(0) == suffix {
+		p.pos++
+	}
+
+	return hour, minute, second, true
+}
+
+func daysUntil(from, to time.Weekday, strict bool) int {
+	days := (int(to) - int(from) + 7) % 7
+
+	if days == 0 && strict {
+		return 7
+	}
+
+	return days
+}
+
+// addMonths adds n months to t, day is clamped to the last day of month (Jan 31 + 1 month is Feb 28)
+func addMonths(t time.Time, n int) time.Time {
+	first := time.Date(t.Year(), t.Month(), 1, t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), t.Location()).AddDate(0, n, 0)
+	day := min(t.Day(), DaysInMonthByDate(first))
+
+	return first.AddDate(0, 0, day-1)
+}
@@ -0,0 +1,355 @@
+package rrule
+
+import (
+	"github.com/gouef/datetime"
+	"github.com/gouef/datetime/internal/wallclock"
+	"iter"
+	"slices"
+	"time"
+)
+
+// maxYear the last year occurrences are searched in, rules which never match end there
+const maxYear = 9999
+
+// expansion rule with defaults taken from start, it works with wall clock in UTC,
+// so that intervals are not shifted by changes of UTC offset
+type expansion struct {
+	rule     *Rule
+	interval int
+	start    time.Time
+	loc      *time.Location
+	weekdays [7]bool
+	nth      []Weekday
+	hours    []int
+	minutes  []int
+	seconds  []int
+	monthDay []int
+	month    []int
+}
+
+// All returns occurrences of rule from start on in location of start, start itself is returned only when it matches rule.
+// Wall clock times in gaps of daylight saving time use UTC offset before the gap and repeated wall clock times are the first occurrence,
+// see section 3.3.5 of RFC 5545
+func (r *Rule) All(start time.Time) iter.Seq[time.Time] {
+	return func(yield func(time.Time) bool) {
+		e := newExpansion(r, start)
+		count := 0
+
+		for period := e.start; period.Year() <= maxYear; period = e.next(period) {
+			for _, occurrence := range e.occurrences(period) {
+				if occurrence.Before(e.start) {
+					continue
+				}
+
+				t := wallclock.In(occurrence, e.loc)
+
+				if e.after(occurrence, t) {
+					return
+				}
+
+				if !yield(t) {
+					return
+				}
+
+				count++
+
+				if r.Count > 0 && count >= r.Count {
+					return
+				}
+			}
+		}
+	}
+}
+
+func newExpansion(r *Rule, start time.Time) *expansion {
+	wall := wallclock.Of(start).Truncate(time.Second)
+
+	e := &expansion{
+		rule:     r,
+		interval: max(r.Interval, 1),
+		start:    wall,
+		loc:      start.Location(),
+		hours:    sorted(r.ByHour),
+		minutes:  sorted(r.ByMinute),
+		seconds:  sorted(r.BySecond),
+		monthDay: r.ByMonthDay,
+		month:    r.ByMonth,
+	}
+
+	days := r.ByDay
+	byDate := len(r.ByWeekNo) > 0 || len(r.ByYearDay) > 0 || len(r.ByMonthDay) > 0 || len(r.ByDay) > 0
+
+	// parts missing in rule are taken from start, see section 3.3.10 of RFC 5545
+	switch {
+	case byDate:
+	case r.Freq == Yearly:
+		if len(e.month) == 0 {
+			e.month = []int{int(wall.Month())}
+		}
+
+		e.monthDay = []int{wall.Day()}
+	case r.Freq == Monthly:
+		e.monthDay = []int{wall.Day()}
+	case r.Freq == Weekly:
+		days = []Weekday{{Weekday: wall.Weekday()}}
+	}
+
+	for _, day := range days {
+		// ordinal is meaningful only in monthly and yearly rules
+		if day.N == 0 || (r.Freq != Monthly && r.Freq != Yearly) {
+			e.weekdays[day.Weekday] = true
+		} else {
+			e.nth = append(e.nth, day)
+		}
+	}
+
+	if len(e.hours) == 0 && r.Freq > Hourly {
+		e.hours = []int{wall.Hour()}
+	}
+
+	if len(e.minutes) == 0 && r.Freq > Minutely {
+		e.minutes = []int{wall.Minute()}
+	}
+
+	if len(e.seconds) == 0 && r.Freq > Secondly {
+		e.seconds = []int{wall.Second()}
+	}
+
+	return e
+}
+
+// after reports whether occurrence is after UNTIL
+func (e *expansion) after(wall, t time.Time) bool {
+	until := e.rule.Until
+
+	switch {
+	case until.IsZero():
+		return false
+	case e.rule.untilLayout == dateLayout || e.rule.untilLayout == floatingLayout:
+		// floating UNTIL is wall clock of occurrences
+		return wall.After(time.Date(until.Year(), until.Month(), until.Day(), until.Hour(), until.Minute(), until.Second(), 0, time.UTC))
+	default:
+		return t.After(until)
+	}
+}
+
+// next returns period interval periods after period
+func (e *expansion) next(period time.Time) time.Time {
+	switch e.rule.Freq {
+	case Yearly:
+		return time.Date(period.Year()+e.interval, 1, 1, 0, 0, 0, 0, time.UTC)
+	case Monthly:
+		return time.Date(period.Year(), period.Month()+time.Month(e.interval), 1, 0, 0, 0, 0, time.UTC)
+	case Weekly:
+		return e.weekStart(period).AddDate(0, 0, 7*e.interval)
+	case Daily:
+		return day(period).AddDate(0, 0, e.interval)
+	}
+
+	step := time.Duration(e.interval) * e.unit()
+	next := period.Add(step)
+
+	// skip periods of days, hours and minutes which can not match
+	switch {
+	case !e.matchesDay(next):
+		return skip(next, day(next).AddDate(0, 0, 1), step)
+	case e.rule.Freq < Hourly && len(e.rule.ByHour) > 0 && !slices.Contains(e.rule.ByHour, next.Hour()):
+		return skip(next, next.Truncate(time.Hour).Add(time.Hour), step)
+	case e.rule.Freq < Minutely && len(e.rule.ByMinute) > 0 && !slices.Contains(e.rule.ByMinute, next.Minute()):
+		return skip(next, next.Truncate(time.Minute).Add(time.Minute), step)
+	}
+
+	return next
+}
+
+// skip returns the first of period, period + step, ... which is not before boundary
+func skip(period, boundary time.Time, step time.Duration) time.Time {
+	steps := (boundary.Sub(period) + step - 1) / step
+
+	return period.Add(steps * step)
+}
+
+func (e *expansion) unit() time.Duration {
+	switch e.rule.Freq {
+	case Hourly:
+		return time.Hour
+	case Minutely:
+		return time.Minute
+	default:
+		return time.Second
+	}
+}
+
+// occurrences returns sorted occurrences of period
+func (e *expansion) occurrences(period time.Time) []time.Time {
+	var days []time.Time
+
+	switch e.rule.Freq {
+	case Yearly:
+		first := time.Date(period.Year(), 1, 1, 0, 0, 0, 0, time.UTC)
+		days = e.filterDays(first, first.AddDate(1, 0, 0))
+	case Monthly:
+		first := time.Date(period.Year(), period.Month(), 1, 0, 0, 0, 0, time.UTC)
+		days = e.filterDays(first, first.AddDate(0, 1, 0))
+	case Weekly:
+		first := e.weekStart(period)
+		days = e.filterDays(first, first.AddDate(0, 0, 7))
+	default:
+		days = e.filterDays(day(period), day(period).AddDate(0, 0, 1))
+	}
+
+	hours := e.timeValues(Hourly, e.hours, period.Hour())
+	minutes := e.timeValues(Minutely, e.minutes, period.Minute())
+	seconds := e.timeValues(Secondly, e.seconds, period.Second())
+
+	var occurrences []time.Time
+
+	for _, d := range days {
+		for _, hour := range hours {
+			for _, minute := range minutes {
+				for _, second := range seconds {
+					occurrences = append(occurrences, d.Add(time.Duration(hour)*time.Hour+time.Duration(minute)*time.Minute+time.Duration(second)*time.Second))
+				}
+			}
+		}
+	}
+
+	if len(e.rule.BySetPos) == 0 {
+		return occurrences
+	}
+
+	var selected []time.Time
+
+	for _, position := range e.rule.BySetPos {
+		index := position - 1
+
+		if position < 0 {
+			index = len(occurrences) + position
+		}
+
+		if index >= 0 && index < len(occurrences) && !slices.ContainsFunc(selected, occurrences[index].Equal) {
+			selected = append(selected, occurrences[index])
+		}
+	}
+
+	slices.SortFunc(selected, time.Time.Compare)
+
+	return selected
+}
+
+// timeValues returns values of unit, the value of period is limited by values when frequency is unit or shorter
+func (e *expansion) timeValues(unit Frequency, values []int, value int) []int {
+	if e.rule.Freq > unit {
+		return values
+	}
+
+	if len(values) == 0 || slices.Contains(values, value) {
+		return []int{value}
+	}
+
+	return nil
+}
+
+func (e *expansion) filterDays(from, to time.Time) []time.Time {
+	var days []time.Time
+
+	for d := from; d.Before(to); d = d.AddDate(0, 0, 1) {
+		if e.matchesDay(d) {
+			days = append(days, d)
+		}
+	}
+
+	return days
+}
+
+func (e *expansion) matchesDay(d time.Time) bool {
+	r := e.rule
+
+	if len(e.month) > 0 && !slices.Contains(e.month, int(d.Month())) {
+		return false
+	}
+
+	if len(r.ByWeekNo) > 0 {
+		week, weeks := e.weekNumber(d)
+
+		if !slices.Contains(r.ByWeekNo, week) && !slices.Contains(r.ByWeekNo, week-weeks-1) {
+			return false
+		}
+	}
+
+	if len(r.ByYearDay) > 0 {
+		yearDay, days := d.YearDay(), daysInYear(d.Year())
+
+		if !slices.Contains(r.ByYearDay, yearDay) && !slices.Contains(r.ByYearDay, yearDay-days-1) {
+			return false
+		}
+	}
+
+	if len(e.monthDay) > 0 {
+		days := datetime.DaysInMonth(d.Year(), int(d.Month()))
+
+		if !slices.Contains(e.monthDay, d.Day()) && !slices.Contains(e.monthDay, d.Day()-days-1) {
+			return false
+		}
+	}
+
+	if e.weekdays == [7]bool{} && len(e.nth) == 0 {
+		return true
+	}
+
+	return e.weekdays[d.Weekday()] || e.matchesNth(d)
+}
+
+// matchesNth reports whether d is n-th weekday of month, or of year in yearly rules without BYMONTH
+func (e *expansion) matchesNth(d time.Time) bool {
+	index, days := d.Day(), datetime.DaysInMonth(d.Year(), int(d.Month()))
+
+	if e.rule.Freq == Yearly && len(e.rule.ByMonth) == 0 {
+		index, days = d.YearDay(), daysInYear(d.Year())
+	}
+
+	for _, day := range e.nth {
+		if day.Weekday != d.Weekday() {
+			continue
+		}
+
+		if day.N == (index-1)/7+1 || day.N == -((days-index)/7+1) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// weekNumber returns week of d and number of weeks of its year, the first week has at least 4 days of the year
+func (e *expansion) weekNumber(d time.Time) (int, int) {
+	start := e.weekStart(d)
+	year := start.AddDate(0, 0, 3).Year()
+	first := e.firstWeek(year)
+
+	return int(start.Sub(first).Hours())/24/7 + 1, int(e.firstWeek(year+1).Sub(first).Hours()) / 24 / 7
+}
+
+func (e *expansion) firstWeek(year int) time.Time {
+	return e.weekStart(time.Date(year, 1, 4, 0, 0, 0, 0, time.UTC))
+}
+
+// weekStart returns the first day of week of t, week starts on WKST
+func (e *expansion) weekStart(t time.Time) time.Time {
+	return day(t).AddDate(0, 0, -((int(t.Weekday()) - int(e.rule.WeekStart) + 7) % 7))
+}
+
+func day(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+func daysInYear(year int) int {
+	return 365 + datetime.DaysInMonth(year, 2) - 28
+}
+
+func sorted(values []int) []int {
+	values = slices.Clone(values)
+	slices.Sort(values)
+
+	return slices.Compact(values)
+}
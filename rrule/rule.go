@@ -0,0 +1,330 @@
+package rrule
+
+import (
+	"fmt"
+	"github.com/gouef/datetime"
+	"github.com/gouef/datetime/internal/wallclock"
+	"math"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Frequency FREQ of rule
+type Frequency int
+
+const (
+	Secondly Frequency = iota
+	Minutely
+	Hourly
+	Daily
+	Weekly
+	Monthly
+	Yearly
+)
+
+var frequencyNames = [...]string{"SECONDLY", "MINUTELY", "HOURLY", "DAILY", "WEEKLY", "MONTHLY", "YEARLY"}
+
+func (f Frequency) String() string {
+	if f < Secondly || f > Yearly {
+		return fmt.Sprintf("Frequency(%d)", int(f))
+	}
+
+	return frequencyNames[f]
+}
+
+var weekdayNames = [...]string{"SU", "MO", "TU", "WE", "TH", "FR", "SA"}
+
+// Weekday BYDAY value, e.g. "-1SU" the last Sunday, N is 0 for every Weekday of period
+type Weekday struct {
+	N       int
+	Weekday time.Weekday
+}
+
+func (w Weekday) String() string {
+	if w.N == 0 {
+		return weekdayNames[w.Weekday]
+	}
+
+	return strconv.Itoa(w.N) + weekdayNames[w.Weekday]
+}
+
+// Rule recurrence rule RRULE of RFC 5545
+type Rule struct {
+	Freq Frequency
+	// Interval 0 means 1
+	Interval int
+	// Count 0 means unlimited
+	Count int
+	// Until inclusive, zero means unlimited
+	Until      time.Time
+	BySecond   []int
+	ByMinute   []int
+	ByHour     []int
+	ByDay      []Weekday
+	ByMonthDay []int
+	ByYearDay  []int
+	ByWeekNo   []int
+	ByMonth    []int
+	BySetPos   []int
+	// WeekStart WKST, Parse sets time.Monday which is default of RFC 5545
+	WeekStart time.Weekday
+
+	// untilLayout layout UNTIL was parsed with, floating UNTIL is compared with wall clock of occurrences
+	untilLayout string
+}
+
+const (
+	dateLayout     = "20060102"
+	floatingLayout = "20060102T150405"
+	utcLayout      = "20060102T150405Z"
+)
+
+// ParseTime parses DATE "19970902" or DATE-TIME "19970902T090000" in loc, DATE-TIME "19970902T090000Z" is in UTC.
+// Wall clock skipped or repeated by daylight saving time is resolved like occurrences of Rule.All
+func ParseTime(value string, loc *time.Location) (time.Time, error) {
+	t, _, err := parseTime(value, loc)
+	return t, err
+}
+
+func parseTime(value string, loc *time.Location) (time.Time, string, error) {
+	layout := floatingLayout
+
+	switch {
+	case len(value) == len(dateLayout):
+		layout = dateLayout
+	case strings.HasSuffix(value, "Z"):
+		layout, loc = utcLayout, time.UTC
+	}
+
+	t, err := time.Parse(layout, value)
+
+	if err != nil {
+		return time.Time{}, "", &datetime.ParseError{Input: value, Expected: "date \"19970902\" or date time \"19970902T090000\"", Err: err}
+	}
+
+	return wallclock.In(t, loc), layout, nil
+}
+
+// Parse parses RRULE value, e.g. "FREQ=MONTHLY;BYDAY=-1FR;COUNT=10", optionally prefixed by "RRULE:"
+func Parse(value string) (*Rule, error) {
+	rule := &Rule{WeekStart: time.Monday}
+	position := 0
+	body := value
+
+	if len(body) >= 6 && strings.EqualFold(body[:6], "RRULE:") {
+		position, body = 6, body[6:]
+	}
+
+	seen := map[string]bool{}
+
+	for _, part := range strings.Split(body, ";") {
+		name, partValue, ok := strings.Cut(part, "=")
+		name = strings.ToUpper(name)
+
+		if !ok || partValue == "" || seen[name] {
+			return nil, &datetime.ParseError{Input: value, Position: position, Expected: "unique rule part \"NAME=VALUE\""}
+		}
+
+		seen[name] = true
+
+		if err := rule.parsePart(name, partValue); err != nil {
+			return nil, &datetime.ParseError{Input: value, Position: position + len(name) + 1, Expected: "value of " + name, Err: err}
+		}
+
+		position += len(part) + 1
+	}
+
+	if !seen["FREQ"] {
+		return nil, &datetime.ParseError{Input: value, Expected: "FREQ"}
+	}
+
+	if seen["COUNT"] && seen["UNTIL"] {
+		return nil, &datetime.ParseError{Input: value, Expected: "COUNT or UNTIL, not both"}
+	}
+
+	return rule, nil
+}
+
+func (r *Rule) parsePart(name, value string) error {
+	var err error
+
+	switch name {
+	case "FREQ":
+		index := slices.Index(frequencyNames[:], strings.ToUpper(value))
+
+		if index < 0 {
+			return fmt.Errorf("%w: unknown frequency %q", datetime.ErrInvalidFormat, value)
+		}
+
+		r.Freq = Frequency(index)
+	case "INTERVAL":
+		r.Interval, err = parseNumber("interval", value, 1, math.MaxInt, false)
+	case "COUNT":
+		r.Count, err = parseNumber("count", value, 1, math.MaxInt, false)
+	case "UNTIL":
+		r.Until, r.untilLayout, err = parseTime(value, time.UTC)
+	case "BYSECOND":
+		r.BySecond, err = parseNumbers("second", value, 0, 60, false)
+	case "BYMINUTE":
+		r.ByMinute, err = parseNumbers("minute", value, 0, 59, false)
+	case "BYHOUR":
+		r.ByHour, err = parseNumbers("hour", value, 0, 23, false)
+	case "BYDAY":
+		r.ByDay, err = parseWeekdays(value)
+	case "BYMONTHDAY":
+		r.ByMonthDay, err = parseNumbers("month day", value, 1, 31, true)
+	case "BYYEARDAY":
+		r.ByYearDay, err = parseNumbers("year day", value, 1, 366, true)
+	case "BYWEEKNO":
+		r.ByWeekNo, err = parseNumbers("week", value, 1, 53, true)
+	case "BYMONTH":
+		r.ByMonth, err = parseNumbers("month", value, 1, 12, false)
+	case "BYSETPOS":
+		r.BySetPos, err = parseNumbers("set position", value, 1, 366, true)
+	case "WKST":
+		weekday, ok := parseWeekdayName(value)
+
+		if !ok {
+			return fmt.Errorf("%w: unknown weekday %q", datetime.ErrInvalidFormat, value)
+		}
+
+		r.WeekStart = weekday
+	default:
+		return fmt.Errorf("%w: unknown rule part %s", datetime.ErrInvalidFormat, name)
+	}
+
+	return err
+}
+
+// parseNumber parses number between min and max, or between -max and -min when negative is allowed
+func parseNumber(field, value string, min, max int, negative bool) (int, error) {
+	n, err := strconv.Atoi(value)
+
+	if err != nil {
+		return 0, fmt.Errorf("%w: %s %q is not a number", datetime.ErrInvalidFormat, field, value)
+	}
+
+	if negative && n < 0 {
+		return n, datetime.CheckField(field, n, -max, -min)
+	}
+
+	return n, datetime.CheckField(field, n, min, max)
+}
+
+func parseNumbers(field, value string, min, max int, negative bool) ([]int, error) {
+	var numbers []int
+
+	for _, item := range strings.Split(value, ",") {
+		n, err := parseNumber(field, item, min, max, negative)
+
+		if err != nil {
+			return nil, err
+		}
+
+		numbers = append(numbers, n)
+	}
+
+	return numbers, nil
+}
+
+func parseWeekdays(value string) ([]Weekday, error) {
+	var weekdays []Weekday
+
+	for _, item := range strings.Split(value, ",") {
+		if len(item) < 2 {
+			return nil, fmt.Errorf("%w: unknown weekday %q", datetime.ErrInvalidFormat, item)
+		}
+
+		weekday, ok := parseWeekdayName(item[len(item)-2:])
+
+		if !ok {
+			return nil, fmt.Errorf("%w: unknown weekday %q", datetime.ErrInvalidFormat, item)
+		}
+
+		n := 0
+
+		if ordinal := strings.TrimPrefix(item[:len(item)-2], "+"); ordinal != "" {
+			var err error
+
+			if n, err = parseNumber("weekday ordinal", ordinal, 1, 53, true); err != nil {
+				return nil, err
+			}
+		}
+
+		weekdays = append(weekdays, Weekday{N: n, Weekday: weekday})
+	}
+
+	return weekdays, nil
+}
+
+func parseWeekdayName(value string) (time.Weekday, bool) {
+	index := slices.Index(weekdayNames[:], strings.ToUpper(value))
+
+	return time.Weekday(index), index >= 0
+}
+
+// String returns RRULE value, e.g. "FREQ=MONTHLY;COUNT=10;BYDAY=-1FR"
+func (r *Rule) String() string {
+	parts := []string{"FREQ=" + r.Freq.String()}
+
+	if !r.Until.IsZero() {
+		layout := r.untilLayout
+		until := r.Until
+
+		if layout == "" || layout == utcLayout {
+			layout, until = utcLayout, until.UTC()
+		}
+
+		parts = append(parts, "UNTIL="+until.Format(layout))
+	}
+
+	if r.Count > 0 {
+		parts = append(parts, "COUNT="+strconv.Itoa(r.Count))
+	}
+
+	if r.Interval > 1 {
+		parts = append(parts, "INTERVAL="+strconv.Itoa(r.Interval))
+	}
+
+	parts = appendNumbers(parts, "BYSECOND", r.BySecond)
+	parts = appendNumbers(parts, "BYMINUTE", r.ByMinute)
+	parts = appendNumbers(parts, "BYHOUR", r.ByHour)
+
+	if len(r.ByDay) > 0 {
+		days := make([]string, len(r.ByDay))
+
+		for i, day := range r.ByDay {
+			days[i] = day.String()
+		}
+
+		parts = append(parts, "BYDAY="+strings.Join(days, ","))
+	}
+
+	parts = appendNumbers(parts, "BYMONTHDAY", r.ByMonthDay)
+	parts = appendNumbers(parts, "BYYEARDAY", r.ByYearDay)
+	parts = appendNumbers(parts, "BYWEEKNO", r.ByWeekNo)
+	parts = appendNumbers(parts, "BYMONTH", r.ByMonth)
+	parts = appendNumbers(parts, "BYSETPOS", r.BySetPos)
+
+	if r.WeekStart != time.Monday {
+		parts = append(parts, "WKST="+weekdayNames[r.WeekStart])
+	}
+
+	return strings.Join(parts, ";")
+}
+
+func appendNumbers(parts []string, name string, numbers []int) []string {
+	if len(numbers) == 0 {
+		return parts
+	}
+
+	items := make([]string, len(numbers))
+
+	for i, n := range numbers {
+		items[i] = strconv.Itoa(n)
+	}
+
+	return append(parts, name+"="+strings.Join(items, ","))
+}
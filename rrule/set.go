@@ -0,0 +1,255 @@
+package rrule
+
+import (
+	"github.com/gouef/datetime"
+	"github.com/gouef/datetime/internal/contentline"
+	"iter"
+	"slices"
+	"strings"
+	"time"
+)
+
+// Set recurrence set of RFC 5545, start and occurrences of rules and RDATE without EXDATE
+type Set struct {
+	// Start DTSTART, the first occurrence, its location is location of all occurrences
+	Start   time.Time
+	Rules   []*Rule
+	RDates  []time.Time
+	ExDates []time.Time
+}
+
+// ParseSet parses content lines DTSTART, RRULE, RDATE and EXDATE, e.g.
+//
+//	DTSTART;TZID=America/New_York:19970902T090000
+//	RRULE:FREQ=DAILY;COUNT=10
+//
+// DATE and DATE-TIME without TZID and "Z" are floating, they are in UTC
+func ParseSet(value string) (*Set, error) {
+	set := &Set{}
+	var dates [][2]string
+	hasStart := false
+
+	for _, line := range contentline.Unfold(value) {
+		name, lineValue, ok := strings.Cut(line, ":")
+
+		if !ok {
+			return nil, &datetime.ParseError{Input: line, Expected: "content line \"NAME:VALUE\""}
+		}
+
+		property, _, _ := strings.Cut(name, ";")
+
+		switch strings.ToUpper(property) {
+		case "DTSTART":
+			if hasStart {
+				return nil, &datetime.ParseError{Input: line, Expected: "single DTSTART"}
+			}
+
+			values, err := parseTimes(name, lineValue, time.UTC)
+
+			if err != nil {
+				return nil, err
+			}
+
+			if len(values) != 1 {
+				return nil, &datetime.ParseError{Input: line, Position: len(name) + 1, Expected: "single date time"}
+			}
+
+			set.Start, hasStart = values[0], true
+		case "RRULE":
+			rule, err := Parse(lineValue)
+
+			if err != nil {
+				return nil, err
+			}
+
+			set.Rules = append(set.Rules, rule)
+		case "RDATE", "EXDATE":
+			// parsed after DTSTART, floating dates are in its location
+			dates = append(dates, [2]string{name, lineValue})
+		default:
+			return nil, &datetime.ParseError{Input: line, Expected: "DTSTART, RRULE, RDATE or EXDATE"}
+		}
+	}
+
+	if !hasStart {
+		return nil, &datetime.ParseError{Input: value, Expected: "DTSTART"}
+	}
+
+	for _, date := range dates {
+		values, err := parseTimes(date[0], date[1], set.Start.Location())
+
+		if err != nil {
+			return nil, err
+		}
+
+		if strings.HasPrefix(strings.ToUpper(date[0]), "RDATE") {
+			set.RDates = append(set.RDates, values...)
+		} else {
+			set.ExDates = append(set.ExDates, values...)
+		}
+	}
+
+	return set, nil
+}
+
+// parseTimes parses comma separated values of property with parameters TZID and VALUE in name, e.g. "EXDATE;TZID=Europe/Prague"
+func parseTimes(name, value string, loc *time.Location) ([]time.Time, error) {
+	_, parameters, _ := strings.Cut(name, ";")
+
+	for _, parameter := range strings.Split(parameters, ";") {
+		key, parameterValue, _ := strings.Cut(parameter, "=")
+
+		switch strings.ToUpper(key) {
+		case "TZID":
+			location, err := time.LoadLocation(strings.Trim(parameterValue, `"`))
+
+			if err != nil {
+				return nil, &datetime.ParseError{Input: name, Expected: "known TZID", Err: err}
+			}
+
+			loc = location
+		case "VALUE":
+			if strings.EqualFold(parameterValue, "PERIOD") {
+				return nil, &datetime.ParseError{Input: name, Expected: "VALUE=DATE or VALUE=DATE-TIME"}
+			}
+		}
+	}
+
+	var times []time.Time
+
+	for _, item := range strings.Split(value, ",") {
+		t, err := ParseTime(item, loc)
+
+		if err != nil {
+			return nil, err
+		}
+
+		times = append(times, t)
+	}
+
+	return times, nil
+}
+
+// Times returns sorted occurrences of set in location of Start, every instant is returned once
+func (s *Set) Times() iter.Seq[time.Time] {
+	return func(yield func(time.Time) bool) {
+		sources := []iter.Seq[time.Time]{slices.Values([]time.Time{s.Start}), s.rDates()}
+
+		for _, rule := range s.Rules {
+			sources = append(sources, rule.All(s.Start))
+		}
+
+		heads := make([]*time.Time, len(sources))
+		nexts := make([]func() (time.Time, bool), len(sources))
+
+		for i, source := range sources {
+			next, stop := iter.Pull(source)
+			defer stop()
+
+			nexts[i] = next
+			heads[i] = pull(next)
+		}
+
+		var last *time.Time
+
+		for {
+			// the earliest head of sources
+			earliest := -1
+
+			for i, head := range heads {
+				if head != nil && (earliest < 0 || head.Before(*heads[earliest])) {
+					earliest = i
+				}
+			}
+
+			if earliest < 0 {
+				return
+			}
+
+			t := *heads[earliest]
+			heads[earliest] = pull(nexts[earliest])
+
+			if (last != nil && t.Equal(*last)) || s.excluded(t) {
+				continue
+			}
+
+			last = &t
+
+			if !yield(t) {
+				return
+			}
+		}
+	}
+}
+
+func pull(next func() (time.Time, bool)) *time.Time {
+	t, ok := next()
+
+	if !ok {
+		return nil
+	}
+
+	return &t
+}
+
+func (s *Set) rDates() iter.Seq[time.Time] {
+	dates := make([]time.Time, len(s.RDates))
+
+	for i, date := range s.RDates {
+		dates[i] = date.In(s.Start.Location())
+	}
+
+	slices.SortFunc(dates, time.Time.Compare)
+
+	return slices.Values(dates)
+}
+
+func (s *Set) excluded(t time.Time) bool {
+	return slices.ContainsFunc(s.ExDates, t.Equal)
+}
+
+// All returns sorted occurrences of set as wall clock in location of Start,
+// occurrences before year 0 which DateTime can not hold are skipped
+func (s *Set) All() iter.Seq[datetime.DateTime] {
+	return func(yield func(datetime.DateTime) bool) {
+		for t := range s.Times() {
+			d, err := wallClock(t)
+
+			if err != nil {
+				continue
+			}
+
+			if !yield(d) {
+				return
+			}
+		}
+	}
+}
+
+// Between returns occurrences of All which are in r, see Range.Is
+func (s *Set) Between(r *datetime.Range) iter.Seq[datetime.DateTime] {
+	to, err := datetime.ParseString(string(r.To().(datetime.Value)))
+	bounded := r.To().(datetime.Value) != "" && err == nil
+
+	return func(yield func(datetime.DateTime) bool) {
+		for d := range s.All() {
+			if bounded && d.After(to) {
+				return
+			}
+
+			if r.Is(d) && !yield(d) {
+				return
+			}
+		}
+	}
+}
+
+func wallClock(t time.Time) (datetime.DateTime, error) {
+	d, err := datetime.NewNano(t.Year(), int(t.Month()), t.Day(), t.Hour(), t.Minute(), t.Second(), t.Nanosecond())
+
+	if err != nil {
+		return datetime.DateTime{}, err
+	}
+
+	return *d, nil
+}
@@ -0,0 +1,30 @@
+package datetime
+
+import (
+	"fmt"
+	"github.com/gouef/datetime/internal/scan"
+)
+
+// scanField scans separator followed by two digits between min and max
+func scanField(value string, i int, separator byte, min, max int, expected string) (int, int, error) {
+	if i >= len(value) || value[i] != separator {
+		return 0, i, parseFailed(value, i, fmt.Sprintf("\"%c\"", separator))
+	}
+
+	n, ok := scan.Digits(value, i+1, 2)
+
+	if !ok || n < min || n > max {
+		return 0, i, parseFailed(value, i+1, expected)
+	}
+
+	return n, i + 3, nil
+}
+
+func parseFailed(value string, position int, expected string) error {
+	return &ParseError{Input: value, Position: position, Expected: expected}
+}
+
+// isSpace matches \s of regexp
+func isSpace(c byte) bool {
+	return c == ' ' || c == '\t' || c == '\n' || c == '\f' || c == '\r'
+}
@@ -0,0 +1,233 @@
+package datetime
+
+import (
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Scan implements sql.Scanner, accepts time.Time, []byte and string
+func (d *DateTime) Scan(value any) error {
+	var parsed Interface
+	var err error
+
+	switch v := value.(type) {
+	case time.Time:
+		v = v.UTC()
+		parsed, err = fromTime(v)
+	case []byte:
+		parsed, err = scanString(string(v))
+	case string:
+		parsed, err = scanString(v)
+	case nil:
+		return fmt.Errorf("%w into DateTime, use NullDateTime", ErrNull)
+	default:
+		return fmt.Errorf("%w %T scanned into DateTime", ErrUnsupportedType, value)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	*d = *parsed.(*DateTime)
+
+	return nil
+}
+
+func scanString(value string) (Interface, error) {
+	d, err := FromString(value)
+
+	if err == nil {
+		return d, nil
+	}
+
+	t, rfcErr := time.Parse(time.RFC3339, value)
+
+	if rfcErr != nil {
+		return nil, err
+	}
+
+	t = t.UTC()
+
+	return fromTime(t)
+}
+
+// Value implements driver.Valuer
+func (d DateTime) Value() (driver.Value, error) {
+	return d.Time(), nil
+}
+
+// NullDateTime DateTime which may be NULL
+type NullDateTime struct {
+	DateTime DateTime
+	Valid    bool
+}
+
+// Scan implements sql.Scanner
+func (n *NullDateTime) Scan(value any) error {
+	if value == nil {
+		n.DateTime, n.Valid = DateTime{}, false
+		return nil
+	}
+
+	n.Valid = true
+
+	return n.DateTime.Scan(value)
+}
+
+// Value implements driver.Valuer
+func (n NullDateTime) Value() (driver.Value, error) {
+	if !n.Valid {
+		return nil, nil
+	}
+
+	return n.DateTime.Value()
+}
+
+// Scan implements sql.Scanner, accepts PostgreSQL tsrange literal
+func (r *Range) Scan(value any) error {
+	literal, err := ScanRangeLiteral(value)
+
+	if err != nil {
+		return err
+	}
+
+	start, from, to, end, err := ParseRangeLiteral(literal)
+
+	if err != nil {
+		return err
+	}
+
+	parsed, err := NewRange(from, to, start, end)
+
+	if err != nil {
+		return err
+	}
+
+	*r = *parsed
+
+	return nil
+}
+
+// Value implements driver.Valuer, returns PostgreSQL tsrange literal
+func (r Range) Value() (driver.Value, error) {
+	return FormatRangeLiteral(r.start, string(r.from), string(r.to), r.end), nil
+}
+
+// ScanRangeLiteral returns range literal from []byte or string value of driver
+func ScanRangeLiteral(value any) (string, error) {
+	switch v := value.(type) {
+	case []byte:
+		return string(v), nil
+	case string:
+		return v, nil
+	case nil:
+		return "", fmt.Errorf("%w into range", ErrNull)
+	}
+
+	return "", fmt.Errorf("%w %T scanned into range", ErrUnsupportedType, value)
+}
+
+// ParseRangeLiteral parses PostgreSQL range literal like ["2026-01-01 00:00:00","2026-02-01 00:00:00"),
+// unbounded and infinite (infinity, -infinity) bounds are returned as empty string
+func ParseRangeLiteral(literal string) (RangeStart, string, string, RangeEnd, error) {
+	literal = strings.TrimSpace(literal)
+
+	if strings.EqualFold(literal, "empty") {
+		return "", "", "", "", ErrEmptyRange
+	}
+
+	if literal == "" || (literal[0] != '[' && literal[0] != '(') {
+		return "", "", "", "", &ParseError{Input: literal, Expected: "\"[\" or \"(\""}
+	}
+
+	if len(literal) < 3 || (literal[len(literal)-1] != ']' && literal[len(literal)-1] != ')') {
+		return "", "", "", "", &ParseError{Input: literal, Position: len(literal) - 1, Expected: "\"]\" or \")\""}
+	}
+
+	inner := literal[1 : len(literal)-1]
+	from, rest, err := parseRangeBound(inner)
+
+	if err != nil {
+		return "", "", "", "", &ParseError{Input: literal, Position: len(literal) - 1, Expected: err.Error()}
+	}
+
+	if !strings.HasPrefix(rest, ",") {
+		return "", "", "", "", &ParseError{Input: literal, Position: 1 + len(inner) - len(rest), Expected: "\",\""}
+	}
+
+	to, rest, err := parseRangeBound(rest[1:])
+
+	if err != nil {
+		return "", "", "", "", &ParseError{Input: literal, Position: len(literal) - 1, Expected: err.Error()}
+	}
+
+	if rest != "" {
+		return "", "", "", "", &ParseError{Input: literal, Position: 1 + len(inner) - len(rest), Expected: "\"]\" or \")\""}
+	}
+
+	return RangeStart(literal[:1]), from, to, RangeEnd(literal[len(literal)-1:]), nil
+}
+
+func parseRangeBound(value string) (string, string, error) {
+	value = strings.TrimLeft(value, " ")
+	var bound strings.Builder
+
+	if strings.HasPrefix(value, `"`) {
+		for i := 1; i < len(value); i++ {
+			switch value[i] {
+			case '\\':
+				if i+1 < len(value) {
+					i++
+					bound.WriteByte(value[i])
+				}
+			case '"':
+				if i+1 < len(value) && value[i+1] == '"' {
+					bound.WriteByte('"')
+					i++
+					continue
+				}
+				return infiniteBound(bound.String()), strings.TrimLeft(value[i+1:], " "), nil
+			default:
+				bound.WriteByte(value[i])
+			}
+		}
+
+		return "", value, errors.New("closing quote")
+	}
+
+	i := strings.IndexByte(value, ',')
+
+	if i < 0 {
+		i = len(value)
+	}
+
+	return infiniteBound(strings.TrimSpace(value[:i])), value[i:], nil
+}
+
+func infiniteBound(bound string) string {
+	if strings.EqualFold(bound, "infinity") || strings.EqualFold(bound, "-infinity") {
+		return ""
+	}
+
+	return bound
+}
+
+// FormatRangeLiteral formats PostgreSQL range literal, empty bound is unbounded
+func FormatRangeLiteral(start RangeStart, from, to string, end RangeEnd) string {
+	return fmt.Sprintf("%s%s,%s%s", start, quoteRangeBound(from), quoteRangeBound(to), end)
+}
+
+func quoteRangeBound(bound string) string {
+	if bound == "" {
+		return ""
+	}
+
+	if strings.ContainsAny(bound, ` ,"\()[]`) {
+		return `"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(bound) + `"`
+	}
+
+	return bound
+}
@@ -0,0 +1,50 @@
+package tests
+
+import (
+	"github.com/gouef/datetime/date"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestAge(t *testing.T) {
+	leapling := newTestDate(2008, 2, 29)
+
+	tests := []struct {
+		name     string
+		birth    *date.Date
+		at       *date.Date
+		policy   date.LeapDayPolicy
+		age      int
+		period   string
+		previous string
+		next     string
+		until    int
+	}{
+		{"day before birthday", newTestDate(1990, 10, 19), newTestDate(2026, 10, 18), date.LeapDayMarch1, 35, "P35Y11M29D", "2025-10-19", "2026-10-19", 1},
+		{"birthday", newTestDate(1990, 10, 18), newTestDate(2026, 10, 18), date.LeapDayMarch1, 36, "P36Y0M0D", "2026-10-18", "2026-10-18", 0},
+		{"end of month", newTestDate(2000, 1, 31), newTestDate(2026, 3, 1), date.LeapDayMarch1, 26, "P26Y1M0D", "2026-01-31", "2027-01-31", 336},
+		{"end of month february 28", newTestDate(2000, 1, 31), newTestDate(2026, 3, 1), date.LeapDayFebruary28, 26, "P26Y1M1D", "2026-01-31", "2027-01-31", 336},
+		{"leapling february 28", leapling, newTestDate(2026, 2, 28), date.LeapDayMarch1, 17, "P17Y11M30D", "2025-03-01", "2026-03-01", 1},
+		{"leapling march 1", leapling, newTestDate(2026, 3, 1), date.LeapDayMarch1, 18, "P18Y0M0D", "2026-03-01", "2026-03-01", 0},
+		{"leapling policy february 28", leapling, newTestDate(2026, 2, 28), date.LeapDayFebruary28, 18, "P18Y0M0D", "2026-02-28", "2026-02-28", 0},
+		{"leapling leap year", leapling, newTestDate(2028, 2, 29), date.LeapDayFebruary28, 20, "P20Y0M0D", "2028-02-29", "2028-02-29", 0},
+		{"leapling day after", leapling, newTestDate(2027, 3, 1), date.LeapDayFebruary28, 19, "P19Y0M1D", "2027-02-28", "2028-02-29", 365},
+		{"before birth", newTestDate(2030, 1, 1), newTestDate(2026, 10, 18), date.LeapDayMarch1, 0, "P0Y0M0D", "2030-01-01", "2030-01-01", 1171},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.age, date.AgeWithPolicy(*tt.birth, *tt.at, tt.policy))
+			assert.Equal(t, tt.period, date.AgePeriod(*tt.birth, *tt.at, tt.policy).String())
+			assert.Equal(t, tt.previous, date.PreviousAnniversary(*tt.birth, *tt.at, tt.policy).ToString())
+			assert.Equal(t, tt.next, date.NextAnniversary(*tt.birth, *tt.at, tt.policy).ToString())
+			assert.Equal(t, tt.until, date.DaysUntilBirthday(*tt.birth, *tt.at, tt.policy))
+		})
+	}
+
+	assert.Equal(t, 17, date.Age(*leapling, *newTestDate(2026, 2, 28)))
+	assert.Equal(t, "2026-03-01", date.TurnsOn(*leapling, 18, date.LeapDayMarch1).ToString())
+	assert.Equal(t, "2026-02-28", date.TurnsOn(*leapling, 18, date.LeapDayFebruary28).ToString())
+	assert.Equal(t, "2032-02-29", date.TurnsOn(*leapling, 24, date.LeapDayMarch1).ToString())
+	assert.Equal(t, -3, date.DaysBetween(*newTestDate(2026, 3, 1), *newTestDate(2026, 2, 26)))
+}
@@ -0,0 +1,185 @@
+package tests
+
+import (
+	"github.com/gouef/datetime"
+	"github.com/gouef/datetime/calendar"
+	"github.com/gouef/datetime/locale"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestMonthGrid(t *testing.T) {
+	tests := []struct {
+		name      string
+		year      int
+		month     time.Month
+		weekStart time.Weekday
+		fixed     bool
+		weeks     int
+		first     string
+		last      string
+	}{
+		{"october from monday", 2026, time.October, time.Monday, false, 5, "2026-09-28", "2026-11-01"},
+		{"october from sunday", 2026, time.October, time.Sunday, false, 5, "2026-09-27", "2026-10-31"},
+		{"october from saturday", 2026, time.October, time.Saturday, false, 6, "2026-09-26", "2026-11-06"},
+		{"february of four weeks", 2026, time.February, time.Sunday, false, 4, "2026-02-01", "2026-02-28"},
+		{"february of fixed weeks", 2026, time.February, time.Sunday, true, 6, "2026-02-01", "2026-03-14"},
+		{"august of six weeks", 2026, time.August, time.Monday, false, 6, "2026-07-27", "2026-09-06"},
+		{"leap february", 2028, time.February, time.Monday, false, 5, "2028-01-31", "2028-03-05"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m, err := calendar.New(tt.year, tt.month, calendar.Options{WeekStart: tt.weekStart, FixedWeeks: tt.fixed})
+			require.NoError(t, err)
+			require.Len(t, m.Weeks, tt.weeks)
+
+			assert.Equal(t, tt.first, m.Weeks[0].Days[0].Date.ToString())
+			assert.Equal(t, tt.last, m.Weeks[len(m.Weeks)-1].Days[6].Date.ToString())
+			assert.Equal(t, tt.weekStart, m.Weekdays()[0])
+
+			for _, week := range m.Weeks {
+				for i, day := range week.Days {
+					assert.Equal(t, m.Weekdays()[i], day.Date.Weekday())
+					assert.Equal(t, day.Date.Month() != int(tt.month), day.Outside)
+				}
+			}
+		})
+	}
+}
+
+func TestMonthWeekNumbers(t *testing.T) {
+	m, err := calendar.New(2027, time.January, calendar.Options{WeekStart: time.Monday})
+	require.NoError(t, err)
+
+	assert.Equal(t, []int{2026, 53}, []int{m.Weeks[0].Year, m.Weeks[0].Number})
+	assert.Equal(t, []int{2027, 1}, []int{m.Weeks[1].Year, m.Weeks[1].Number})
+
+	// weeks from Sunday take week of their Monday
+	m, err = calendar.New(2027, time.January, calendar.Options{WeekStart: time.Sunday})
+	require.NoError(t, err)
+
+	assert.Equal(t, "2026-12-27", m.Weeks[0].Days[0].Date.ToString())
+	assert.Equal(t, 53, m.Weeks[0].Number)
+	assert.Equal(t, 1, m.Weeks[1].Number)
+}
+
+func TestMonthMarks(t *testing.T) {
+	datetime.SetClock(datetime.NewFakeClock(time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)))
+	defer datetime.SetClock(nil)
+
+	holidays := datetime.NewHolidayCalendar(time.Date(2026, 10, 28, 0, 0, 0, 0, time.UTC))
+	m, err := calendar.New(2026, time.October, calendar.Options{
+		WeekStart: time.Monday,
+		Weekend:   calendar.Weekdays{time.Friday, time.Saturday},
+		Holidays:  holidays,
+	})
+	require.NoError(t, err)
+
+	days := map[string]calendar.Day{}
+
+	for _, week := range m.Weeks {
+		for _, day := range week.Days {
+			days[day.Date.ToString()] = day
+		}
+	}
+
+	assert.True(t, days["2026-10-28"].Holiday)
+	assert.False(t, days["2026-10-28"].Weekend)
+	assert.True(t, days["2026-10-30"].Weekend)
+	assert.True(t, days["2026-10-31"].Weekend)
+	assert.False(t, days["2026-10-25"].Weekend)
+	assert.True(t, days["2026-10-19"].Today)
+	assert.False(t, days["2026-10-20"].Today)
+	assert.True(t, days["2026-09-28"].Outside)
+	assert.False(t, days["2026-10-01"].Outside)
+
+	// holiday calendar is weekend policy too
+	m, err = calendar.New(2026, time.October, calendar.Options{Weekend: holidays.WithWeekend(time.Sunday)})
+	require.NoError(t, err)
+
+	assert.True(t, m.Weeks[1].Days[0].Weekend)
+	assert.False(t, m.Weeks[1].Days[6].Weekend)
+}
+
+func TestMonthErrors(t *testing.T) {
+	_, err := calendar.New(2026, 13, calendar.Options{})
+	assert.ErrorIs(t, err, datetime.ErrOutOfRange)
+
+	_, err = calendar.New(0, time.January, calendar.Options{})
+	assert.ErrorIs(t, err, datetime.ErrOutOfRange)
+}
+
+func TestTextRenderer(t *testing.T) {
+	sunday, err := calendar.New(2026, time.October, calendar.Options{})
+	require.NoError(t, err)
+	monday, err := calendar.New(2026, time.October, calendar.Options{WeekStart: time.Monday})
+	require.NoError(t, err)
+
+	tests := []struct {
+		name     string
+		renderer calendar.TextRenderer
+		month    *calendar.Month
+		expected []string
+	}{
+		{"cal", calendar.TextRenderer{}, sunday, []string{
+			"    October 2026",
+			"Su Mo Tu We Th Fr Sa",
+			"             1  2  3",
+			" 4  5  6  7  8  9 10",
+			"11 12 13 14 15 16 17",
+			"18 19 20 21 22 23 24",
+			"25 26 27 28 29 30 31",
+		}},
+		{"week numbers and outside days", calendar.TextRenderer{Locale: locale.MustGet("cs"), WeekNumbers: true, Outside: true}, monday, []string{
+			"      říjen 2026",
+			"   po út st čt pá so ne",
+			"40 28 29 30  1  2  3  4",
+			"41  5  6  7  8  9 10 11",
+			"42 12 13 14 15 16 17 18",
+			"43 19 20 21 22 23 24 25",
+			"44 26 27 28 29 30 31  1",
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, strings.Join(tt.expected, "\n")+"\n", tt.renderer.Render(tt.month))
+		})
+	}
+
+	assert.Equal(t, calendar.TextRenderer{}.Render(sunday), sunday.String())
+}
+
+func TestHTMLRenderer(t *testing.T) {
+	datetime.SetClock(datetime.NewFakeClock(time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)))
+	defer datetime.SetClock(nil)
+
+	m, err := calendar.New(2026, time.October, calendar.Options{
+		WeekStart: time.Monday,
+		Holidays:  datetime.NewHolidayCalendar(time.Date(2026, 10, 28, 0, 0, 0, 0, time.UTC)),
+	})
+	require.NoError(t, err)
+
+	result := calendar.HTMLRenderer{WeekNumbers: true}.Render(m)
+
+	assert.True(t, strings.HasPrefix(result, "<table class=\"calendar\">\n<caption>October 2026</caption>\n<thead>\n"+
+		"<tr><th class=\"week\"></th><th scope=\"col\" abbr=\"Monday\">Mon</th><th scope=\"col\" abbr=\"Tuesday\">Tue</th>"))
+	assert.True(t, strings.HasSuffix(result, "</tr>\n</tbody>\n</table>\n"))
+	assert.Equal(t, 5, strings.Count(result, "<th scope=\"row\" class=\"week\">"))
+	assert.Contains(t, result, "<tr><th scope=\"row\" class=\"week\">40</th><td class=\"outside\"></td><td class=\"outside\"></td><td class=\"outside\"></td><td><time datetime=\"2026-10-01\">1</time></td>")
+	assert.Contains(t, result, "<td class=\"weekend\"><time datetime=\"2026-10-03\">3</time></td>")
+	assert.Contains(t, result, "<td class=\"holiday\"><time datetime=\"2026-10-28\">28</time></td>")
+	assert.Contains(t, result, "<td class=\"today\"><time datetime=\"2026-10-19\">19</time></td>")
+
+	result = calendar.HTMLRenderer{Outside: true, Class: "month \"small\"", Locale: locale.MustGet("cs")}.Render(m)
+
+	assert.Contains(t, result, "<table class=\"month &#34;small&#34;\">\n<caption>říjen 2026</caption>")
+	assert.Contains(t, result, "<th scope=\"col\" abbr=\"pondělí\">po</th>")
+	assert.Contains(t, result, "<td class=\"outside\"><time datetime=\"2026-09-28\">28</time></td>")
+	assert.Contains(t, result, "<td class=\"outside weekend\"><time datetime=\"2026-11-01\">1</time></td>")
+	assert.NotContains(t, result, "class=\"week\"")
+}
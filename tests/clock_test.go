@@ -0,0 +1,117 @@
+package tests
+
+import (
+	"context"
+	"github.com/gouef/datetime"
+	"github.com/gouef/datetime/date"
+	"github.com/gouef/datetime/time"
+	"github.com/stretchr/testify/assert"
+	"sync"
+	"testing"
+	goTime "time"
+)
+
+func TestClockNow(t *testing.T) {
+	clock := datetime.NewFakeClock(goTime.Date(2026, 10, 18, 14, 5, 9, 0, goTime.UTC))
+	datetime.SetClock(clock)
+	defer datetime.SetClock(nil)
+
+	assert.Equal(t, "2026-10-18 14:05:09", datetime.Now().ToString())
+	assert.Equal(t, "2026-10-18", date.Now().ToString())
+	assert.Equal(t, "14:05:09", time.Now().ToString())
+
+	clock.Advance(10 * goTime.Hour)
+	assert.Equal(t, "2026-10-19 00:05:09", datetime.Now().ToString())
+	assert.Equal(t, "2026-10-19", date.Now().ToString())
+
+	tomorrow, err := datetime.ParseRelative("tomorrow", nil)
+	assert.NoError(t, err)
+	assert.Equal(t, "2026-10-20 00:00:00", tomorrow.ToString())
+
+	other := datetime.NewFakeClock(goTime.Date(2000, 1, 2, 3, 4, 5, 0, goTime.UTC))
+	ctx := datetime.WithClock(context.Background(), other)
+
+	assert.Equal(t, "2000-01-02 03:04:05", datetime.NowContext(ctx).ToString())
+	assert.Equal(t, "2000-01-02", date.NowContext(ctx).ToString())
+	assert.Equal(t, "03:04:05", time.NowContext(ctx).ToString())
+	assert.Equal(t, "2026-10-19 00:05:09", datetime.NowContext(context.Background()).ToString())
+
+	datetime.SetClock(nil)
+	assert.IsType(t, datetime.SystemClock{}, datetime.GetClock())
+}
+
+func TestFakeClockTimers(t *testing.T) {
+	start := goTime.Date(2026, 1, 1, 0, 0, 0, 0, goTime.UTC)
+	clock := datetime.NewFakeClock(start)
+
+	timer := clock.NewTimer(5 * goTime.Second)
+	after := clock.After(goTime.Minute)
+	ticker := clock.NewTicker(20 * goTime.Second)
+	immediate := clock.NewTimer(0)
+
+	assert.Equal(t, start, <-immediate.C())
+	assert.Equal(t, 3, clock.Waiters())
+
+	clock.Advance(4 * goTime.Second)
+	assertNoTick(t, timer.C())
+
+	clock.Advance(goTime.Second)
+	assert.Equal(t, start.Add(5*goTime.Second), <-timer.C())
+	assert.False(t, timer.Stop())
+
+	clock.Advance(20 * goTime.Second)
+	assert.Equal(t, start.Add(20*goTime.Second), <-ticker.C())
+
+	clock.Set(start.Add(goTime.Minute))
+	assert.Equal(t, start.Add(40*goTime.Second), <-ticker.C())
+	assertNoTick(t, ticker.C())
+	assert.Equal(t, start.Add(goTime.Minute), <-after)
+
+	ticker.Reset(goTime.Hour)
+	clock.Advance(30 * goTime.Minute)
+	assertNoTick(t, ticker.C())
+	clock.Advance(30 * goTime.Minute)
+	assert.Equal(t, start.Add(goTime.Hour+goTime.Minute), <-ticker.C())
+
+	assert.False(t, timer.Reset(goTime.Second))
+	clock.Advance(goTime.Second)
+	assert.Equal(t, start.Add(goTime.Hour+goTime.Minute+goTime.Second), <-timer.C())
+
+	ticker.Stop()
+	assert.Equal(t, 0, clock.Waiters())
+
+	clock.Set(start)
+	assert.Equal(t, start, clock.Now())
+}
+
+func TestFakeClockConcurrent(t *testing.T) {
+	clock := datetime.NewFakeClock(goTime.Date(2026, 1, 1, 0, 0, 0, 0, goTime.UTC))
+	var wg sync.WaitGroup
+
+	for i := 0; i < 10; i++ {
+		wg.Add(2)
+
+		go func() {
+			defer wg.Done()
+			timer := clock.NewTimer(goTime.Second)
+			clock.Advance(goTime.Second)
+			<-timer.C()
+		}()
+
+		go func() {
+			defer wg.Done()
+			_ = datetime.NowContext(datetime.WithClock(context.Background(), clock))
+		}()
+	}
+
+	wg.Wait()
+	assert.Equal(t, goTime.Date(2026, 1, 1, 0, 0, 10, 0, goTime.UTC), clock.Now())
+}
+
+func assertNoTick(t *testing.T, c <-chan goTime.Time) {
+	select {
+	case tick := <-c:
+		t.Errorf("unexpected tick %s", tick)
+	default:
+	}
+}
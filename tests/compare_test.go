@@ -0,0 +1,76 @@
+package tests
+
+import (
+	"github.com/gouef/datetime"
+	"github.com/gouef/datetime/time"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	goTime "time"
+)
+
+func TestCompareAt(t *testing.T) {
+	morning, _ := datetime.NewNano(2026, 10, 18, 9, 30, 15, 250000000)
+	evening, _ := datetime.New(2026, 10, 18, 21, 0, 0)
+	nextMonth, _ := datetime.New(2026, 11, 1, 0, 0, 0)
+	day := newTestDate(2026, 10, 18)
+	nextDay := newTestDate(2026, 10, 19)
+	nine := newTestTime(9, 30, 15)
+
+	tests := []struct {
+		name        string
+		a           datetime.Interface
+		b           datetime.Interface
+		granularity datetime.Granularity
+		expected    int
+		err         bool
+	}{
+		{"same day", morning, evening, datetime.GranularityDay, 0, false},
+		{"hours", morning, evening, datetime.GranularityHour, -1, false},
+		{"month", evening, nextMonth, datetime.GranularityMonth, -1, false},
+		{"year", evening, nextMonth, datetime.GranularityYear, 0, false},
+		{"datetime and date", evening, day, datetime.GranularityDay, 0, false},
+		{"date and datetime", nextDay, evening, datetime.GranularityDay, 1, false},
+		{"dates", day, nextDay, datetime.GranularityMonth, 0, false},
+		{"times second", nine, newTestTime(9, 30, 15), datetime.GranularitySecond, 0, false},
+		{"times nanosecond", time.FromDateTime(*morning), nine, datetime.GranularityNanosecond, 1, false},
+		{"times millisecond", time.FromDateTime(*morning), nine, datetime.GranularityMillisecond, 1, false},
+		{"times second of datetime", time.FromDateTime(*morning), nine, datetime.GranularitySecond, 0, false},
+		{"datetime seconds", morning, morning.Truncate(goTime.Second), datetime.GranularitySecond, 0, false},
+		{"datetime and date at hour", evening, day, datetime.GranularityHour, 0, true},
+		{"datetime and time at minute", morning, nine, datetime.GranularityMinute, 0, true},
+		{"date and time at day", day, nine, datetime.GranularityDay, 0, true},
+		{"time and date at second", nine, day, datetime.GranularitySecond, 0, true},
+		{"times at day", nine, nine, datetime.GranularityDay, 0, true},
+		{"unknown granularity", morning, evening, datetime.Granularity(42), 0, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, err := datetime.CompareAt(tt.a, tt.b, tt.granularity)
+
+			if tt.err {
+				assert.ErrorIs(t, err, datetime.ErrIncomparable)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, c)
+		})
+	}
+
+	c, err := day.CompareAt(evening, datetime.GranularityDay)
+	assert.NoError(t, err)
+	assert.Equal(t, 0, c)
+
+	_, err = nine.CompareAt(day, datetime.GranularityHour)
+	assert.ErrorIs(t, err, datetime.ErrIncomparable)
+	assert.Equal(t, "values are not comparable: time and date at granularity hour", err.Error())
+
+	equal, err := datetime.EqualAt(morning, day, datetime.GranularityDay)
+	assert.NoError(t, err)
+	assert.True(t, equal)
+
+	c, err = morning.CompareAt(nextMonth, datetime.GranularityYear)
+	assert.NoError(t, err)
+	assert.Equal(t, 0, c)
+}
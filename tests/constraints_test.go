@@ -0,0 +1,120 @@
+package tests
+
+import (
+	"github.com/gouef/datetime"
+	"github.com/gouef/datetime/constraints"
+	"github.com/gouef/datetime/date"
+	"github.com/gouef/datetime/time"
+	"github.com/gouef/validator"
+	"github.com/stretchr/testify/assert"
+	"math"
+	"testing"
+	goTime "time"
+)
+
+func TestConstraints(t *testing.T) {
+	// Friday
+	datetime.SetClock(datetime.NewFakeClock(goTime.Date(2026, 10, 16, 14, 5, 9, 0, goTime.UTC)))
+	defer datetime.SetClock(nil)
+
+	mustDate := func(value string) *date.Date {
+		d, err := date.FromString(value)
+		assert.NoError(t, err)
+		return d.(*date.Date)
+	}
+	window, err := date.RangeFromString("[2026-12-24, 2026-12-26]")
+	assert.NoError(t, err)
+	holidays := datetime.NewHolidayCalendar(goTime.Date(2026, 10, 28, 0, 0, 0, 0, goTime.UTC))
+
+	tests := []struct {
+		name       string
+		constraint validator.Constraint
+		value      any
+		valid      bool
+	}{
+		{"after now", constraints.DateAfter{}, "2026-10-16 14:05:10", true},
+		{"after now equal", constraints.DateAfter{}, "2026-10-16 14:05:09", false},
+		{"after inclusive", constraints.DateAfter{Date: mustDate("2026-10-16"), Inclusive: true}, date.Value("2026-10-16"), true},
+		{"after exclusive", constraints.DateAfter{Date: mustDate("2026-10-16")}, mustDate("2026-10-16"), false},
+		{"before now", constraints.DateBefore{}, goTime.Date(2026, 10, 16, 0, 0, 0, 0, goTime.UTC), true},
+		{"before date", constraints.DateBefore{Date: mustDate("2026-01-01")}, "2026-01-01", false},
+		{"before inclusive", constraints.DateBefore{Date: mustDate("2026-01-01"), Inclusive: true}, "2026-01-01", true},
+		{"in range", constraints.DateInRange{Range: window}, "2026-12-25", true},
+		{"out of range", constraints.DateInRange{Range: window}, datetime.Value("2026-12-27 00:00:00"), false},
+		{"business day", constraints.IsBusinessDay{}, "2026-10-16", true},
+		{"business day weekend", constraints.IsBusinessDay{}, "2026-10-17", false},
+		{"business day holiday", constraints.IsBusinessDay{Calendar: holidays}, "2026-10-28", false},
+		{"not weekend", constraints.NotWeekend{}, "2026-10-19", true},
+		{"not weekend sunday", constraints.NotWeekend{}, "2026-10-18", false},
+		{"delivery monday", constraints.MinBusinessDays{Days: 2}, "2026-10-19", false},
+		{"delivery tuesday", constraints.MinBusinessDays{Days: 2}, "2026-10-20", true},
+		{"delivery holiday", constraints.MinBusinessDays{Days: 8, Calendar: holidays}, "2026-10-28", false},
+		{"min age", constraints.MinAge{Years: 18}, "2008-10-16", true},
+		{"min age day before birthday", constraints.MinAge{Years: 18}, "2008-10-17", false},
+		{"min age leap birthday", constraints.MinAge{Years: 18, At: mustDate("2026-02-28")}, "2008-02-29", false},
+		{"min age leap birthday march", constraints.MinAge{Years: 18, At: mustDate("2026-03-01")}, "2008-02-29", true},
+		{"min age leap birthday policy", constraints.MinAge{Years: 18, At: mustDate("2026-02-28"), LeapDay: date.LeapDayFebruary28}, "2008-02-29", true},
+		{"valid string", constraints.ValidDateTimeString{}, "2026-10-16 14:05:09", true},
+		{"invalid string", constraints.ValidDateTimeString{}, "2026-02-30 14:05:09", false},
+		{"valid pattern", constraints.ValidDateTimeString{Pattern: datetime.ICU("dd.MM.yyyy")}, "16.10.2026", true},
+		{"invalid pattern", constraints.ValidDateTimeString{Pattern: datetime.ICU("dd.MM.yyyy")}, "2026-10-16", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			errs := validator.Validate(tt.value, tt.constraint)
+
+			if tt.valid {
+				assert.Empty(t, errs)
+			} else {
+				assert.Len(t, errs, 1)
+			}
+		})
+	}
+}
+
+func TestConstraintErrors(t *testing.T) {
+	err := constraints.NotWeekend{}.Validate("2026-10-18")
+	assert.ErrorIs(t, err, constraints.ErrViolation)
+	assert.Equal(t, "constraint violation: this value must not be weekend", err.Error())
+
+	err = constraints.MinBusinessDays{Days: 2, From: datetime.Value("2026-10-16 14:05:09").Date()}.Validate("2026-10-19")
+	assert.Equal(t, "constraint violation: this value must be 2026-10-20 or later", err.Error())
+
+	assert.ErrorIs(t, constraints.NotWeekend{}.Validate(1), datetime.ErrUnsupportedType)
+	assert.ErrorIs(t, constraints.NotWeekend{}.Validate(nil), datetime.ErrUnsupportedType)
+	assert.ErrorIs(t, constraints.NotWeekend{}.Validate((*datetime.DateTime)(nil)), datetime.ErrUnsupportedType)
+	assert.ErrorIs(t, constraints.NotWeekend{}.Validate((*date.Date)(nil)), datetime.ErrUnsupportedType)
+	assert.ErrorIs(t, constraints.NotWeekend{}.Validate((*datetime.Value)(nil)), datetime.ErrUnsupportedType)
+	assert.ErrorIs(t, constraints.NotWeekend{}.Validate("someday"), datetime.ErrInvalidFormat)
+	assert.ErrorIs(t, constraints.ValidDateTimeString{}.Validate(goTime.Now()), datetime.ErrUnsupportedType)
+	assert.ErrorIs(t, constraints.ValidDateTimeString{}.Validate("2026-02-30 14:05:09"), datetime.ErrOutOfRange)
+}
+
+func TestStructValidate(t *testing.T) {
+	tests := []struct {
+		name  string
+		value interface{ Validate() error }
+	}{
+		{"datetime", newTestDateTime(2026, 10, 18, 14, 5, 9)},
+		{"date", newTestDate(2024, 2, 29)},
+		{"time", newTestTime(23, 59, 59)},
+		{"zero datetime", datetime.DateTime{}},
+		{"zero date", date.Date{}},
+		{"zero time", time.Time{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.NoError(t, tt.value.Validate())
+		})
+	}
+
+	var rangeErr *datetime.FieldRangeError
+
+	// values out of range can be made only by arithmetic and conversions
+	assert.ErrorAs(t, newTestDateTime(0, 1, 1, 0, 30, 0).StartOfWeek(goTime.Monday).Validate(), &rangeErr)
+	assert.Equal(t, &datetime.FieldRangeError{Field: "year", Value: -1, Min: 0, Max: math.MaxInt}, rangeErr)
+	assert.ErrorAs(t, date.FromTime(goTime.Date(-1, 12, 31, 0, 0, 0, 0, goTime.UTC)).Validate(), &rangeErr)
+	assert.Equal(t, "year", rangeErr.Field)
+}
@@ -0,0 +1,138 @@
+package tests
+
+import (
+	"github.com/gouef/datetime"
+	"github.com/gouef/datetime/date"
+	"github.com/gouef/datetime/time"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	goTime "time"
+)
+
+func TestConversions(t *testing.T) {
+	d, _ := datetime.NewNano(2026, 10, 18, 14, 5, 9, 500000000)
+
+	assert.Equal(t, "2026-10-18", date.FromDateTime(*d).ToString())
+	assert.Equal(t, "14:05:09.5", time.FromDateTime(*d).ToString())
+
+	prague, err := goTime.LoadLocation("Europe/Prague")
+	assert.NoError(t, err)
+
+	tests := []struct {
+		name     string
+		date     *date.Date
+		time     *time.Time
+		location *goTime.Location
+		expected string
+	}{
+		{"utc", newTestDate(2026, 10, 18), newTestTime(14, 5, 9), nil, "2026-10-18 14:05:09"},
+		{"prague summer", newTestDate(2026, 7, 1), newTestTime(9, 0, 0), prague, "2026-07-01 07:00:00"},
+		{"prague winter", newTestDate(2026, 12, 1), newTestTime(0, 30, 0), prague, "2026-11-30 23:30:00"},
+		// 02:30 does not exist on the day of daylight saving time change
+		{"prague gap", newTestDate(2026, 3, 29), newTestTime(2, 30, 0), prague, "2026-03-29 01:30:00"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			at, err := tt.date.At(*tt.time, tt.location)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, at.ToString())
+		})
+	}
+
+	round, err := date.FromDateTime(*d).At(time.FromDateTime(*d), nil)
+	assert.NoError(t, err)
+	assert.True(t, round.Equal(d))
+}
+
+func TestRangeConversions(t *testing.T) {
+	d, _ := datetime.New(2026, 10, 18, 14, 5, 9)
+	days, _ := date.RangeFromString("[2026-10-01, 2026-10-31]")
+	hours, _ := time.RangeFromString("[09:00:00, 17:00:00]")
+	dates, _ := datetime.RangeFromString("[2026-10-01 00:00:00, 2026-10-31 00:00:00]")
+
+	assert.True(t, days.Is(d))
+	assert.True(t, days.Is(*d))
+	assert.True(t, hours.Is(d))
+	assert.True(t, hours.Is(*d))
+	assert.True(t, dates.Is(*d))
+	assert.True(t, hours.Is(*newTestTime(10, 0, 0)))
+	assert.True(t, days.Is(*newTestDate(2026, 10, 2)))
+}
+
+func TestWindows(t *testing.T) {
+	prague, _ := goTime.LoadLocation("Europe/Prague")
+	march, _ := date.RangeFromString("[2026-03-01, 2026-03-31]")
+	office, _ := time.RangeFromString("[09:00:00, 17:00:00]")
+	weekdays := func(d date.Date) bool { return !d.IsWeekend() }
+
+	windows, err := date.Windows(march, office, nil, weekdays)
+	assert.NoError(t, err)
+
+	var all []string
+	for window := range windows {
+		all = append(all, window.String())
+	}
+
+	assert.Len(t, all, 22)
+	assert.Equal(t, "[2026-03-02 09:00:00, 2026-03-02 17:00:00]", all[0])
+	assert.Equal(t, "[2026-03-31 09:00:00, 2026-03-31 17:00:00]", all[21])
+
+	windows, err = date.Windows(march, office, prague, weekdays)
+	assert.NoError(t, err)
+
+	all = nil
+	for window := range windows {
+		all = append(all, window.String())
+	}
+
+	assert.Equal(t, "[2026-03-27 08:00:00, 2026-03-27 16:00:00]", all[19])
+	assert.Equal(t, "[2026-03-30 07:00:00, 2026-03-30 15:00:00]", all[20])
+
+	tests := []struct {
+		name     string
+		days     string
+		hours    string
+		expected []string
+	}{
+		{"overnight", "[2026-03-01, 2026-03-02]", "[22:00:00, 06:00:00)", []string{
+			"[2026-03-01 22:00:00, 2026-03-02 06:00:00)",
+			"[2026-03-02 22:00:00, 2026-03-03 06:00:00)",
+		}},
+		{"open hours", "[2026-03-01, 2026-03-01]", "[12:00:00, ]", []string{
+			"[2026-03-01 12:00:00, 2026-03-01 23:59:59.999999999]",
+		}},
+		{"endless days", "[2026-03-01, ]", "(, 08:00:00)", []string{
+			"(2026-03-01 00:00:00, 2026-03-01 08:00:00)",
+			"(2026-03-02 00:00:00, 2026-03-02 08:00:00)",
+			"(2026-03-03 00:00:00, 2026-03-03 08:00:00)",
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			days, err := date.RangeFromString(tt.days)
+			assert.NoError(t, err)
+			hours, err := time.RangeFromString(tt.hours)
+			assert.NoError(t, err)
+
+			windows, err := date.Windows(days, hours, nil, nil)
+			assert.NoError(t, err)
+
+			var all []string
+			for window := range windows {
+				all = append(all, window.String())
+
+				if len(all) == len(tt.expected) {
+					break
+				}
+			}
+
+			assert.Equal(t, tt.expected, all)
+		})
+	}
+
+	unbounded, _ := date.RangeFromString("[, 2026-03-31]")
+	_, err = date.Windows(unbounded, office, nil, nil)
+	assert.ErrorIs(t, err, date.ErrUnboundedRange)
+}
@@ -0,0 +1,258 @@
+package tests
+
+import (
+	"github.com/gouef/datetime"
+	"github.com/gouef/datetime/cron"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+func TestScheduleNext(t *testing.T) {
+	monday := time.Date(2026, 10, 19, 10, 15, 30, 0, time.UTC)
+
+	tests := []struct {
+		expression string
+		from       time.Time
+		expected   string
+	}{
+		{"*/15 * * * *", monday, "2026-10-19T10:30:00Z"},
+		{"5/20 * * * *", monday, "2026-10-19T10:25:00Z"},
+		{"0 9 * * 1-5", monday, "2026-10-20T09:00:00Z"},
+		{"0 9 * * MON-FRI", time.Date(2026, 10, 23, 9, 0, 0, 0, time.UTC), "2026-10-26T09:00:00Z"},
+		{"30 * * * * *", monday, "2026-10-19T10:16:30Z"},
+		{"@hourly", monday, "2026-10-19T11:00:00Z"},
+		{"@daily", monday, "2026-10-20T00:00:00Z"},
+		{"@weekly", monday, "2026-10-25T00:00:00Z"},
+		{"@monthly", monday, "2026-11-01T00:00:00Z"},
+		{"@yearly", monday, "2027-01-01T00:00:00Z"},
+		{"0 0 * * 7", monday, "2026-10-25T00:00:00Z"},
+		{"0 0 ? * SUN", monday, "2026-10-25T00:00:00Z"},
+		{"0 0 L * *", monday, "2026-10-31T00:00:00Z"},
+		{"0 0 L-2 * *", monday, "2026-10-29T00:00:00Z"},
+		{"0 0 LW * *", monday, "2026-10-30T00:00:00Z"},
+		{"0 0 15W * *", monday, "2026-11-16T00:00:00Z"},
+		{"0 0 1W * *", monday, "2026-11-02T00:00:00Z"},
+		{"0 0 1W * *", time.Date(2026, 7, 15, 0, 0, 0, 0, time.UTC), "2026-08-03T00:00:00Z"},
+		{"0 12 * * 5L", monday, "2026-10-30T12:00:00Z"},
+		{"0 10 * * MON#2", monday, "2026-11-09T10:00:00Z"},
+		{"0 0 1,15 * MON", monday, "2026-10-26T00:00:00Z"},
+		{"0 0 13 * FRI", monday, "2026-10-23T00:00:00Z"},
+		// day of month starting with "*" is not restriction combined by OR like in Vixie cron
+		{"0 0 */1 * MON", monday, "2026-10-26T00:00:00Z"},
+		{"0 0 */2 * MON", monday, "2026-11-09T00:00:00Z"},
+		{"0 0 1-31/2 * MON", monday, "2026-10-21T00:00:00Z"},
+		{"0 0 29 2 *", monday, "2028-02-29T00:00:00Z"},
+		{"0 */2 * JAN-MAR *", monday, "2027-01-01T00:00:00Z"},
+		{"CRON_TZ=America/New_York 0 9 * * *", monday, "2026-10-19T13:00:00Z"},
+		// 02:30 is skipped on March 29, 2026 in Prague, it fires at 03:00 after the gap
+		{"TZ=Europe/Prague 30 2 * * *", time.Date(2026, 3, 28, 12, 0, 0, 0, time.UTC), "2026-03-29T01:00:00Z"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expression, func(t *testing.T) {
+			s, err := cron.Parse(tt.expression)
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.expected, s.Next(tt.from).UTC().Format(time.RFC3339))
+		})
+	}
+}
+
+func TestSchedulePrevious(t *testing.T) {
+	monday := time.Date(2026, 10, 19, 10, 15, 30, 0, time.UTC)
+
+	tests := []struct {
+		expression string
+		expected   string
+	}{
+		{"*/15 * * * *", "2026-10-19T10:15:00Z"},
+		{"30 * * * * *", "2026-10-19T10:14:30Z"},
+		{"0 9 * * 1-5", "2026-10-19T09:00:00Z"},
+		{"0 0 L * *", "2026-09-30T00:00:00Z"},
+		{"0 12 * * 5L", "2026-09-25T12:00:00Z"},
+		{"@yearly", "2026-01-01T00:00:00Z"},
+		{"0 0 29 2 *", "2024-02-29T00:00:00Z"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expression, func(t *testing.T) {
+			s, err := cron.Parse(tt.expression)
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.expected, s.Previous(monday).UTC().Format(time.RFC3339))
+		})
+	}
+}
+
+func TestScheduleNever(t *testing.T) {
+	s := cron.MustParse("0 0 30 2 *")
+
+	assert.True(t, s.Next(time.Now()).IsZero())
+	assert.True(t, s.Previous(time.Now()).IsZero())
+}
+
+func TestScheduleRepeatedWallClock(t *testing.T) {
+	s := cron.MustParse("CRON_TZ=Europe/Prague 30 2 * * *")
+
+	// 02:30 is twice on October 25, 2026 in Prague
+	first := s.Next(time.Date(2026, 10, 24, 12, 0, 0, 0, time.UTC))
+	second := s.Next(first)
+
+	assert.Equal(t, "2026-10-25 02:30", first.Format("2006-01-02 15:04"))
+	assert.Equal(t, "2026-10-26 02:30", second.Format("2006-01-02 15:04"))
+	assert.Equal(t, "Europe/Prague", s.Location().String())
+}
+
+func TestScheduleSkippedWallClock(t *testing.T) {
+	// 02:00 to 03:00 is skipped on March 8, 2026 in New York
+	s := cron.MustParse("CRON_TZ=America/New_York 30 2 * * *")
+
+	first := s.Next(time.Date(2026, 3, 7, 12, 0, 0, 0, time.UTC))
+	second := s.Next(first)
+
+	assert.Equal(t, "2026-03-08T03:00:00-04:00", first.Format(time.RFC3339))
+	assert.Equal(t, "2026-03-09T02:30:00-04:00", second.Format(time.RFC3339))
+	assert.Equal(t, first, s.Previous(time.Date(2026, 3, 8, 12, 0, 0, 0, time.UTC)))
+	assert.Equal(t, "2026-03-07T02:30:00-05:00", s.Previous(first).Format(time.RFC3339))
+
+	// all fire times in the gap fire once at its end
+	s = cron.MustParse("CRON_TZ=America/New_York */15 * * * *")
+
+	var result []string
+
+	for fire := s.Next(time.Date(2026, 3, 8, 6, 50, 0, 0, time.UTC)); len(result) < 3; fire = s.Next(fire) {
+		result = append(result, fire.Format(time.RFC3339))
+	}
+
+	assert.Equal(t, []string{"2026-03-08T03:00:00-04:00", "2026-03-08T03:15:00-04:00", "2026-03-08T03:30:00-04:00"}, result)
+}
+
+func TestScheduleBetween(t *testing.T) {
+	s := cron.MustParse("0 9 * * 1-5")
+	r, err := datetime.NewRangeStrict("2026-10-23 00:00:00", "2026-10-28 00:00:00")
+	require.NoError(t, err)
+
+	var result []string
+
+	for d := range s.Between(r) {
+		result = append(result, d.ToString())
+	}
+
+	assert.Equal(t, []string{"2026-10-23 09:00:00", "2026-10-26 09:00:00", "2026-10-27 09:00:00"}, result)
+}
+
+func TestScheduleBetweenFromNow(t *testing.T) {
+	tokyo, err := time.LoadLocation("Asia/Tokyo")
+	require.NoError(t, err)
+
+	// 05:00 in New York
+	datetime.SetClock(datetime.NewFakeClock(time.Date(2026, 10, 19, 18, 0, 0, 0, tokyo)))
+	defer datetime.SetClock(nil)
+
+	s := cron.MustParse("CRON_TZ=America/New_York 0 9 * * *")
+	r, err := datetime.NewRangeStrict("", "2026-10-21 00:00:00")
+	require.NoError(t, err)
+
+	var result []string
+
+	for d := range s.Between(r) {
+		result = append(result, d.ToString())
+	}
+
+	assert.Equal(t, []string{"2026-10-19 09:00:00", "2026-10-20 09:00:00"}, result)
+}
+
+func TestScheduleBetweenBeforeYearZero(t *testing.T) {
+	datetime.SetClock(datetime.NewFakeClock(time.Date(-1, 12, 31, 23, 0, 0, 0, time.UTC)))
+	defer datetime.SetClock(nil)
+
+	s := cron.MustParse("0 * * * *")
+	r, err := datetime.NewRangeStrict("", "0000-01-01 01:30:00")
+	require.NoError(t, err)
+
+	var result []string
+
+	for d := range s.Between(r) {
+		result = append(result, d.ToString())
+	}
+
+	assert.Equal(t, []string{"0000-01-01 00:00:00", "0000-01-01 01:00:00"}, result)
+}
+
+func TestScheduleDescribe(t *testing.T) {
+	tests := []struct {
+		expression string
+		expected   string
+	}{
+		{"0 9 * * 1-5", "At 09:00, on Monday through Friday"},
+		{"*/15 * * * *", "Every 15 minutes"},
+		{"*/10 * * * * *", "Every 10 seconds"},
+		{"15 30 9 * * *", "At 09:30:15"},
+		{"5-10 9 * * *", "At minutes 5 through 10 past hour 09"},
+		{"@daily", "At 00:00"},
+		{"0 0 L * *", "At 00:00, on the last day of the month"},
+		{"0 0 L-3,LW * *", "At 00:00, on 3 days before the last day and the last weekday of the month"},
+		{"30 8 15W * *", "At 08:30, on the weekday nearest day 15 of the month"},
+		{"0 12 * * 5L", "At 12:00, on the last Friday of the month"},
+		{"0 10 * * MON#2", "At 10:00, on the second Monday of the month"},
+		{"0 0 1,15 * MON", "At 00:00, on days 1 and 15 of the month or on Monday"},
+		{"0 0 */2 * MON", "At 00:00, on days every 2 days of the month and on Monday"},
+		{"0 0 */1 * MON", "At 00:00, on Monday"},
+		{"0 */2 * JAN-MAR *", "At minute 0 past every 2 hours, in January through March"},
+		{"0 0 1 */3 *", "At 00:00, on day 1 of the month, every 3 months"},
+		{"CRON_TZ=Europe/Prague 0 7 * * *", "At 07:00, in time zone Europe/Prague"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expression, func(t *testing.T) {
+			assert.Equal(t, tt.expected, cron.MustParse(tt.expression).Describe())
+		})
+	}
+}
+
+func TestScheduleParseErrors(t *testing.T) {
+	tests := []struct {
+		expression string
+		err        error
+	}{
+		{"* * * *", datetime.ErrInvalidFormat},
+		{"60 * * * *", datetime.ErrOutOfRange},
+		{"* * * * 8", datetime.ErrOutOfRange},
+		{"* * * * MON#6", datetime.ErrOutOfRange},
+		{"*/0 * * * *", datetime.ErrOutOfRange},
+		{"5-1 * * * *", datetime.ErrInvalidFormat},
+		{"? * * * *", datetime.ErrInvalidFormat},
+		{"L * * * *", datetime.ErrInvalidFormat},
+		{"0 0 LX * *", datetime.ErrInvalidFormat},
+		{"@reboot", datetime.ErrInvalidFormat},
+		{"CRON_TZ=Mars/Olympus * * * * *", datetime.ErrInvalidFormat},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expression, func(t *testing.T) {
+			_, err := cron.Parse(tt.expression)
+
+			assert.ErrorIs(t, err, tt.err)
+		})
+	}
+}
+
+func TestScheduleParseErrorPosition(t *testing.T) {
+	_, err := cron.Parse("0 25 * * *")
+
+	var parseError *datetime.ParseError
+
+	require.ErrorAs(t, err, &parseError)
+	assert.Equal(t, 2, parseError.Position)
+	assert.Equal(t, "hour", parseError.Expected)
+}
+
+func TestScheduleString(t *testing.T) {
+	s := cron.MustParse("CRON_TZ=Europe/Prague 0 7 * * *")
+
+	assert.Equal(t, "CRON_TZ=Europe/Prague 0 7 * * *", s.String())
+	assert.Nil(t, cron.MustParse("0 7 * * *").Location())
+	assert.Panics(t, func() { cron.MustParse("0 7 * *") })
+}
@@ -17,7 +17,7 @@ func TestNewDate(t *testing.T) {
 		expected *date.Date
 		err      bool
 	}{
-		{2024, 12, 25, &date.Date{Year: 2024, Month: 12, Day: 25, DateTime: time.Date(2024, 12, 25, 0, 0, 0, 0, time.UTC)}, false},
+		{2024, 12, 25, newTestDate(2024, 12, 25), false},
 		{2024, 2, 30, nil, true},   // Invalid day for February
 		{2024, 13, 10, nil, true},  // Invalid month (13)
 		{2024, 4, 31, nil, true},   // April has only 30 days
@@ -42,9 +42,9 @@ func TestDateIsWeekend(t *testing.T) {
 		date     *date.Date
 		expected bool
 	}{
-		{&date.Date{Year: 2024, Month: 12, Day: 21, DateTime: time.Date(2024, 12, 21, 0, 0, 0, 0, time.UTC)}, true},  // Saturday
-		{&date.Date{Year: 2024, Month: 12, Day: 22, DateTime: time.Date(2024, 12, 22, 0, 0, 0, 0, time.UTC)}, true},  // Sunday
-		{&date.Date{Year: 2024, Month: 12, Day: 23, DateTime: time.Date(2024, 12, 23, 0, 0, 0, 0, time.UTC)}, false}, // Monday
+		{newTestDate(2024, 12, 21), true},  // Saturday
+		{newTestDate(2024, 12, 22), true},  // Sunday
+		{newTestDate(2024, 12, 23), false}, // Monday
 	}
 
 	for _, tt := range tests {
@@ -60,12 +60,12 @@ func TestDateCompare(t *testing.T) {
 		date2    *date.Date
 		expected int
 	}{
-		{&date.Date{Year: 2024, Month: 12, Day: 25, DateTime: time.Date(2024, 12, 25, 0, 0, 0, 0, time.UTC)},
-			&date.Date{Year: 2024, Month: 12, Day: 25, DateTime: time.Date(2024, 12, 25, 0, 0, 0, 0, time.UTC)}, 0},
-		{&date.Date{Year: 2024, Month: 12, Day: 25, DateTime: time.Date(2024, 12, 25, 0, 0, 0, 0, time.UTC)},
-			&date.Date{Year: 2024, Month: 12, Day: 26, DateTime: time.Date(2024, 12, 26, 0, 0, 0, 0, time.UTC)}, -1}, // 25th < 26th
-		{&date.Date{Year: 2024, Month: 12, Day: 26, DateTime: time.Date(2024, 12, 26, 0, 0, 0, 0, time.UTC)},
-			&date.Date{Year: 2024, Month: 12, Day: 25, DateTime: time.Date(2024, 12, 25, 0, 0, 0, 0, time.UTC)}, 1}, // 26th > 25th
+		{newTestDate(2024, 12, 25),
+			newTestDate(2024, 12, 25), 0},
+		{newTestDate(2024, 12, 25),
+			newTestDate(2024, 12, 26), -1}, // 25th < 26th
+		{newTestDate(2024, 12, 26),
+			newTestDate(2024, 12, 25), 1}, // 26th > 25th
 	}
 
 	for _, tt := range tests {
@@ -83,26 +83,26 @@ func TestDateEqual(t *testing.T) {
 	}{
 		// Test 1: Equal DateTime instances
 		{
-			date1:    &date.Date{Year: 2024, Month: 3, Day: 31},
-			date2:    &date.Date{Year: 2024, Month: 3, Day: 31},
+			date1:    newTestDate(2024, 3, 31),
+			date2:    newTestDate(2024, 3, 31),
 			expected: true,
 		},
 		// Test 2: Different DateTime instances (different day)
 		{
-			date1:    &date.Date{Year: 2024, Month: 3, Day: 31},
-			date2:    &date.Date{Year: 2024, Month: 3, Day: 30},
+			date1:    newTestDate(2024, 3, 31),
+			date2:    newTestDate(2024, 3, 30),
 			expected: false,
 		},
 		// Test 3: Different DateTime instances (different month)
 		{
-			date1:    &date.Date{Year: 2024, Month: 3, Day: 31},
-			date2:    &date.Date{Year: 2024, Month: 4, Day: 1},
+			date1:    newTestDate(2024, 3, 31),
+			date2:    newTestDate(2024, 4, 1),
 			expected: false,
 		},
 		// Test 4: Different DateTime instances (different year)
 		{
-			date1:    &date.Date{Year: 2024, Month: 3, Day: 31},
-			date2:    &date.Date{Year: 2025, Month: 3, Day: 31},
+			date1:    newTestDate(2024, 3, 31),
+			date2:    newTestDate(2025, 3, 31),
 			expected: false,
 		},
 	}
@@ -422,3 +422,25 @@ func TestDateRange(t *testing.T) {
 		}
 	})
 }
+
+func TestDateAccessors(t *testing.T) {
+	d := newTestDate(2024, 2, 29)
+
+	assert.Equal(t, []int{2024, 2, 29}, []int{d.Year(), d.Month(), d.Day()})
+	assert.Equal(t, time.Thursday, d.Weekday())
+	assert.False(t, d.IsZero())
+
+	var zero date.Date
+	assert.True(t, zero.IsZero())
+	assert.Equal(t, "0001-01-01", zero.ToString())
+}
+
+func newTestDate(year, month, day int) *date.Date {
+	d, err := date.New(year, month, day)
+
+	if err != nil {
+		panic(err)
+	}
+
+	return d.(*date.Date)
+}
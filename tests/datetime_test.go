@@ -20,7 +20,7 @@ func TestNewDateTime(t *testing.T) {
 		expected *datetime.DateTime
 		err      bool
 	}{
-		{2024, 12, 25, 0, 0, 0, &datetime.DateTime{Year: 2024, Month: 12, Day: 25, DateTime: time.Date(2024, 12, 25, 0, 0, 0, 0, time.UTC)}, false},
+		{2024, 12, 25, 0, 0, 0, newTestDateTime(2024, 12, 25, 0, 0, 0), false},
 		{2024, 2, 30, 0, 0, 0, nil, true},
 		{2024, 13, 10, 0, 0, 0, nil, true},
 		{2024, 4, 31, 0, 0, 0, nil, true},
@@ -53,9 +53,9 @@ func TestIsWeekend(t *testing.T) {
 		date     *datetime.DateTime
 		expected bool
 	}{
-		{&datetime.DateTime{Year: 2024, Month: 12, Day: 21, DateTime: time.Date(2024, 12, 21, 0, 0, 0, 0, time.UTC)}, true},  // Saturday
-		{&datetime.DateTime{Year: 2024, Month: 12, Day: 22, DateTime: time.Date(2024, 12, 22, 0, 0, 0, 0, time.UTC)}, true},  // Sunday
-		{&datetime.DateTime{Year: 2024, Month: 12, Day: 23, DateTime: time.Date(2024, 12, 23, 0, 0, 0, 0, time.UTC)}, false}, // Monday
+		{newTestDateTime(2024, 12, 21, 0, 0, 0), true},  // Saturday
+		{newTestDateTime(2024, 12, 22, 0, 0, 0), true},  // Sunday
+		{newTestDateTime(2024, 12, 23, 0, 0, 0), false}, // Monday
 	}
 
 	for _, tt := range tests {
@@ -71,12 +71,12 @@ func TestCompare(t *testing.T) {
 		date2    *datetime.DateTime
 		expected int
 	}{
-		{&datetime.DateTime{Year: 2024, Month: 12, Day: 25, DateTime: time.Date(2024, 12, 25, 0, 0, 0, 0, time.UTC)},
-			&datetime.DateTime{Year: 2024, Month: 12, Day: 25, DateTime: time.Date(2024, 12, 25, 0, 0, 0, 0, time.UTC)}, 0},
-		{&datetime.DateTime{Year: 2024, Month: 12, Day: 25, DateTime: time.Date(2024, 12, 25, 0, 0, 0, 0, time.UTC)},
-			&datetime.DateTime{Year: 2024, Month: 12, Day: 26, DateTime: time.Date(2024, 12, 26, 0, 0, 0, 0, time.UTC)}, -1}, // 25th < 26th
-		{&datetime.DateTime{Year: 2024, Month: 12, Day: 26, DateTime: time.Date(2024, 12, 26, 0, 0, 0, 0, time.UTC)},
-			&datetime.DateTime{Year: 2024, Month: 12, Day: 25, DateTime: time.Date(2024, 12, 25, 0, 0, 0, 0, time.UTC)}, 1}, // 26th > 25th
+		{newTestDateTime(2024, 12, 25, 0, 0, 0),
+			newTestDateTime(2024, 12, 25, 0, 0, 0), 0},
+		{newTestDateTime(2024, 12, 25, 0, 0, 0),
+			newTestDateTime(2024, 12, 26, 0, 0, 0), -1}, // 25th < 26th
+		{newTestDateTime(2024, 12, 26, 0, 0, 0),
+			newTestDateTime(2024, 12, 25, 0, 0, 0), 1}, // 26th > 25th
 	}
 
 	for _, tt := range tests {
@@ -94,26 +94,26 @@ func TestDateTimeEqual(t *testing.T) {
 	}{
 		// Test 1: Equal DateTime instances
 		{
-			date1:    &datetime.DateTime{Year: 2024, Month: 3, Day: 31},
-			date2:    &datetime.DateTime{Year: 2024, Month: 3, Day: 31},
+			date1:    newTestDateTime(2024, 3, 31, 0, 0, 0),
+			date2:    newTestDateTime(2024, 3, 31, 0, 0, 0),
 			expected: true,
 		},
 		// Test 2: Different DateTime instances (different day)
 		{
-			date1:    &datetime.DateTime{Year: 2024, Month: 3, Day: 31},
-			date2:    &datetime.DateTime{Year: 2024, Month: 3, Day: 30},
+			date1:    newTestDateTime(2024, 3, 31, 0, 0, 0),
+			date2:    newTestDateTime(2024, 3, 30, 0, 0, 0),
 			expected: false,
 		},
 		// Test 3: Different DateTime instances (different month)
 		{
-			date1:    &datetime.DateTime{Year: 2024, Month: 3, Day: 31},
-			date2:    &datetime.DateTime{Year: 2024, Month: 4, Day: 1},
+			date1:    newTestDateTime(2024, 3, 31, 0, 0, 0),
+			date2:    newTestDateTime(2024, 4, 1, 0, 0, 0),
 			expected: false,
 		},
 		// Test 4: Different DateTime instances (different year)
 		{
-			date1:    &datetime.DateTime{Year: 2024, Month: 3, Day: 31},
-			date2:    &datetime.DateTime{Year: 2025, Month: 3, Day: 31},
+			date1:    newTestDateTime(2024, 3, 31, 0, 0, 0),
+			date2:    newTestDateTime(2025, 3, 31, 0, 0, 0),
 			expected: false,
 		},
 	}
@@ -443,3 +443,37 @@ func TestRange(t *testing.T) {
 		}
 	})
 }
+
+func TestDateTimeAccessors(t *testing.T) {
+	d := newTestDateTime(2026, 10, 18, 14, 5, 9)
+
+	assert.Equal(t, []int{2026, 10, 18, 14, 5, 9}, []int{d.Year(), d.Month(), d.Day(), d.Hour(), d.Minute(), d.Second()})
+	assert.Equal(t, time.Sunday, d.Weekday())
+	assert.False(t, d.IsZero())
+
+	var zero datetime.DateTime
+	assert.True(t, zero.IsZero())
+	assert.Equal(t, "0001-01-01 00:00:00", zero.ToString())
+	assert.NoError(t, zero.Validate())
+
+	// Saturday 23:30 in UTC-5 is Sunday in UTC, wall clock is kept
+	clock := datetime.NewFakeClock(time.Date(2026, 10, 17, 23, 30, 0, 0, time.FixedZone("EST", -5*3600)))
+	datetime.SetClock(clock)
+	defer datetime.SetClock(nil)
+
+	now := datetime.Now()
+	assert.Equal(t, "2026-10-17 23:30:00", now.ToString())
+	assert.Equal(t, time.Date(2026, 10, 17, 23, 30, 0, 0, time.UTC), now.Time())
+	assert.Equal(t, time.Saturday, now.Weekday())
+	assert.Equal(t, now.Time().Weekday(), now.Weekday())
+}
+
+func newTestDateTime(year, month, day, hour, minute, second int) *datetime.DateTime {
+	d, err := datetime.New(year, month, day, hour, minute, second)
+
+	if err != nil {
+		panic(err)
+	}
+
+	return d
+}
@@ -0,0 +1,94 @@
+package tests
+
+import (
+	"github.com/gouef/datetime"
+	"github.com/gouef/datetime/date"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestYearFraction(t *testing.T) {
+	holidays := datetime.NewHolidayCalendar(datetime.GetDate(2026, 10, 28))
+
+	tests := []struct {
+		name       string
+		start      *date.Date
+		end        *date.Date
+		convention date.DayCount
+		options    date.DayCountOptions
+		fraction   float64
+	}{
+		// 30/360 family
+		{"30/360 US half year", newTestDate(2007, 1, 15), newTestDate(2007, 7, 15), date.Thirty360US, date.DayCountOptions{}, 0.5},
+		{"30/360 US end of february", newTestDate(2007, 2, 28), newTestDate(2007, 3, 31), date.Thirty360US, date.DayCountOptions{}, 30.0 / 360},
+		{"30/360 US february to february", newTestDate(2008, 2, 29), newTestDate(2009, 2, 28), date.Thirty360US, date.DayCountOptions{}, 1},
+		{"30/360 US 31st after 30th", newTestDate(2007, 8, 31), newTestDate(2008, 2, 28), date.Thirty360US, date.DayCountOptions{}, 178.0 / 360},
+		{"30E/360 end of february", newTestDate(2007, 2, 28), newTestDate(2007, 3, 31), date.Thirty360European, date.DayCountOptions{}, 32.0 / 360},
+		{"30E/360 february to february", newTestDate(2008, 2, 29), newTestDate(2009, 2, 28), date.Thirty360European, date.DayCountOptions{}, 359.0 / 360},
+		{"30E/360 31st", newTestDate(2007, 8, 31), newTestDate(2008, 2, 28), date.Thirty360European, date.DayCountOptions{}, 178.0 / 360},
+		{"30E/360 ISDA end of february", newTestDate(2007, 2, 28), newTestDate(2007, 3, 31), date.Thirty360ISDA, date.DayCountOptions{}, 30.0 / 360},
+		{"30E/360 ISDA february to february", newTestDate(2008, 2, 29), newTestDate(2009, 2, 28), date.Thirty360ISDA, date.DayCountOptions{}, 1},
+		{"30E/360 ISDA february maturity", newTestDate(2008, 2, 29), newTestDate(2009, 2, 28), date.Thirty360ISDA, date.DayCountOptions{Maturity: *newTestDate(2009, 2, 28)}, 358.0 / 360},
+		{"30E/360 ISDA 31st", newTestDate(2007, 8, 31), newTestDate(2008, 2, 28), date.Thirty360ISDA, date.DayCountOptions{}, 178.0 / 360},
+
+		// actual
+		{"ACT/360", newTestDate(2007, 1, 15), newTestDate(2007, 7, 15), date.Actual360, date.DayCountOptions{}, 181.0 / 360},
+		{"ACT/365F", newTestDate(2007, 1, 15), newTestDate(2007, 7, 15), date.Actual365Fixed, date.DayCountOptions{}, 181.0 / 365},
+		{"ACT/365F leap year", newTestDate(2008, 1, 1), newTestDate(2009, 1, 1), date.Actual365Fixed, date.DayCountOptions{}, 366.0 / 365},
+
+		// ISDA EMU memo examples
+		{"ACT/ACT ISDA regular", newTestDate(2003, 11, 1), newTestDate(2004, 5, 1), date.ActualActualISDA, date.DayCountOptions{}, 61.0/365 + 121.0/366},
+		{"ACT/ACT ISDA short first", newTestDate(1999, 2, 1), newTestDate(1999, 7, 1), date.ActualActualISDA, date.DayCountOptions{}, 150.0 / 365},
+		{"ACT/ACT ISDA long first", newTestDate(2002, 8, 15), newTestDate(2003, 7, 15), date.ActualActualISDA, date.DayCountOptions{}, 334.0 / 365},
+		{"ACT/ACT ISDA short final", newTestDate(1999, 7, 30), newTestDate(2000, 1, 30), date.ActualActualISDA, date.DayCountOptions{}, 155.0/365 + 29.0/366},
+		{"ACT/ACT ISDA several years", newTestDate(2003, 7, 1), newTestDate(2005, 7, 1), date.ActualActualISDA, date.DayCountOptions{}, 184.0/365 + 1 + 181.0/365},
+		{"ACT/ACT ICMA regular", newTestDate(2003, 11, 1), newTestDate(2004, 5, 1), date.ActualActualICMA, date.DayCountOptions{Frequency: 2}, 0.5},
+		{"ACT/ACT ICMA short first", newTestDate(1999, 2, 1), newTestDate(1999, 7, 1), date.ActualActualICMA, date.DayCountOptions{Frequency: 1}, 150.0 / 365},
+		{"ACT/ACT ICMA long first", newTestDate(2002, 8, 15), newTestDate(2003, 7, 15), date.ActualActualICMA, date.DayCountOptions{Frequency: 1}, 334.0 / 365},
+		{"ACT/ACT ICMA short final", newTestDate(1999, 7, 30), newTestDate(2000, 1, 30), date.ActualActualICMA, date.DayCountOptions{Frequency: 2}, 0.5},
+		{"ACT/ACT ICMA long semiannual", newTestDate(2002, 8, 15), newTestDate(2003, 7, 15), date.ActualActualICMA, date.DayCountOptions{Frequency: 2}, 153.0/(2*184) + 0.5},
+		{"ACT/ACT ICMA accrued", newTestDate(2003, 11, 1), newTestDate(2004, 2, 1), date.ActualActualICMA, date.DayCountOptions{Frequency: 2, CouponDate: *newTestDate(2004, 5, 1)}, 92.0 / (2 * 182)},
+
+		// business days
+		{"BUS/252 week", newTestDate(2026, 10, 16), newTestDate(2026, 10, 23), date.Business252, date.DayCountOptions{}, 5.0 / 252},
+		{"BUS/252 holiday", newTestDate(2026, 10, 26), newTestDate(2026, 11, 2), date.Business252, date.DayCountOptions{Calendar: holidays}, 4.0 / 252},
+
+		{"reversed", newTestDate(2007, 7, 15), newTestDate(2007, 1, 15), date.Actual360, date.DayCountOptions{}, -181.0 / 360},
+		{"same day", newTestDate(2007, 7, 15), newTestDate(2007, 7, 15), date.ActualActualISDA, date.DayCountOptions{}, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fraction, err := date.YearFractionWithOptions(*tt.start, *tt.end, tt.convention, tt.options)
+
+			assert.NoError(t, err)
+			assert.InDelta(t, tt.fraction, fraction, 1e-12)
+		})
+	}
+}
+
+func TestYearFractionErrors(t *testing.T) {
+	tests := []struct {
+		name       string
+		convention date.DayCount
+		message    string
+	}{
+		{"ICMA without frequency", date.ActualActualICMA, "invalid day count convention ACT/ACT ICMA needs frequency dividing 12, got 0"},
+		{"unknown convention", date.DayCount(99), "invalid day count convention DayCount(99)"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := date.YearFraction(*newTestDate(2024, 1, 1), *newTestDate(2024, 7, 1), tt.convention)
+
+			assert.ErrorIs(t, err, date.ErrInvalidDayCount)
+			assert.EqualError(t, err, tt.message)
+		})
+	}
+}
+
+func TestDayCountString(t *testing.T) {
+	assert.Equal(t, "30/360 US", date.Thirty360US.String())
+	assert.Equal(t, "ACT/ACT ICMA", date.ActualActualICMA.String())
+	assert.Equal(t, "BUS/252", date.Business252.String())
+}
@@ -0,0 +1,124 @@
+package tests
+
+import (
+	"errors"
+	"github.com/gouef/datetime"
+	"github.com/gouef/datetime/date"
+	"github.com/gouef/datetime/locale"
+	"github.com/gouef/datetime/time"
+	"github.com/stretchr/testify/assert"
+	"math"
+	"testing"
+	goTime "time"
+)
+
+func TestFieldRangeError(t *testing.T) {
+	newErr := func(_ any, err error) error { return err }
+
+	tests := []struct {
+		name     string
+		err      error
+		expected *datetime.FieldRangeError
+	}{
+		{"datetime year", newErr(datetime.New(-1, 1, 1, 0, 0, 0)), &datetime.FieldRangeError{Field: "year", Value: -1, Min: 0, Max: math.MaxInt}},
+		{"datetime month", newErr(datetime.New(2026, 13, 1, 0, 0, 0)), &datetime.FieldRangeError{Field: "month", Value: 13, Min: 1, Max: 12}},
+		{"datetime day", newErr(datetime.New(2026, 2, 29, 0, 0, 0)), &datetime.FieldRangeError{Field: "day", Value: 29, Min: 1, Max: 28}},
+		{"datetime second", newErr(datetime.New(2026, 2, 1, 0, 0, 60)), &datetime.FieldRangeError{Field: "second", Value: 60, Min: 0, Max: 59}},
+		{"date day", newErr(date.New(2024, 2, 30)), &datetime.FieldRangeError{Field: "day", Value: 30, Min: 1, Max: 29}},
+		{"date from string", newErr(date.FromString("2026-13-01")), &datetime.FieldRangeError{Field: "month", Value: 13, Min: 1, Max: 12}},
+		{"time hour", newErr(time.New(24, 0, 0)), &datetime.FieldRangeError{Field: "hour", Value: 24, Min: 0, Max: 23}},
+		{"time minute", newErr(time.New(10, 61, 0)), &datetime.FieldRangeError{Field: "minute", Value: 61, Min: 0, Max: 59}},
+		{"parse pattern", newErr(datetime.Parse(datetime.ICU("yyyy-MM-dd"), "2026-02-30")), &datetime.FieldRangeError{Field: "day", Value: 30, Min: 1, Max: 28}},
+		{"excel serial", newErr(datetime.ParseAny("0", datetime.ParseAnyOptions{Numeric: datetime.NumericExcel})), &datetime.FieldRangeError{Field: "excel serial", Value: 0, Min: 1, Max: 2958465}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var rangeErr *datetime.FieldRangeError
+
+			assert.ErrorIs(t, tt.err, datetime.ErrOutOfRange)
+			assert.ErrorAs(t, tt.err, &rangeErr)
+			assert.Equal(t, tt.expected, rangeErr)
+		})
+	}
+
+	assert.Equal(t, "month must be between 1-12 get \"13\"", (&datetime.FieldRangeError{Field: "month", Value: 13, Min: 1, Max: 12}).Error())
+	assert.Equal(t, "year must be 0 or greater get \"-1\"", (&datetime.FieldRangeError{Field: "year", Value: -1, Min: 0, Max: math.MaxInt}).Error())
+}
+
+func TestParseError(t *testing.T) {
+	newErr := func(_ any, err error) error { return err }
+	_, _, _, _, literalErr := datetime.ParseRangeLiteral(`["2026-01-01 00:00:00" "2026-02-01 00:00:00")`)
+
+	tests := []struct {
+		name     string
+		err      error
+		input    string
+		position int
+		expected string
+	}{
+		{"datetime", newErr(datetime.FromString("2026-10-18")), "2026-10-18", 10, "space"},
+		{"date", newErr(date.FromString("18.10.2026")), "18.10.2026", 0, "year of 4 digits"},
+		{"date separator", newErr(date.FromString("2026/10/18")), "2026/10/18", 4, "\"-\""},
+		{"datetime month", newErr(datetime.FromString("2026-13-18 10:00:00")), "2026-13-18 10:00:00", 5, "month 01-12"},
+		{"time", newErr(time.FromString("noon")), "noon", 0, "time \"15:04:05\""},
+		{"date range", newErr(date.RangeFromString("<2026-01-01, 2026-02-01>")), "<2026-01-01, 2026-02-01>", 0, "date range \"[2006-01-02, 2006-01-02]\""},
+		{"datetime value", newErr(datetime.StringToValue("yesterday")), "yesterday", 0, "year"},
+		{"pattern literal", newErr(datetime.ParseTime(datetime.ICU("yyyy-MM-dd"), "2026/10/18")), "2026/10/18", 4, "\"-\""},
+		{"pattern digits", newErr(datetime.ParseTime(datetime.ICU("yyyy-MM-dd"), "2026-x0-18")), "2026-x0-18", 5, "2 digits"},
+		{"pattern trailing", newErr(datetime.ParseTime(datetime.ICU("yyyy-MM-dd"), "2026-10-18 12")), "2026-10-18 12", 10, "end of value"},
+		{"relative", newErr(datetime.ParseRelative("next  fortnight", nil)), "next  fortnight", 6, "unit"},
+		{"relative expect", newErr(datetime.ParseRelative("first monday in march", nil)), "first monday in march", 13, "\"of\""},
+		{"relative empty", newErr(datetime.ParseRelative("  ", nil)), "  ", 2, "relative expression"},
+		{"range literal", literalErr, `["2026-01-01 00:00:00" "2026-02-01 00:00:00")`, 23, "\",\""},
+		{"parse any", newErr(datetime.ParseAny("someday", datetime.ParseAnyOptions{})), "someday", 0, "date time of supported layout"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var parseErr *datetime.ParseError
+
+			assert.ErrorIs(t, tt.err, datetime.ErrInvalidFormat)
+			assert.ErrorAs(t, tt.err, &parseErr)
+			assert.Equal(t, tt.input, parseErr.Input)
+			assert.Equal(t, tt.position, parseErr.Position)
+			assert.Equal(t, tt.expected, parseErr.Expected)
+		})
+	}
+
+	_, err := datetime.Parse(datetime.ICU("yyyy-MM-dd"), "2026-02-30")
+	assert.ErrorIs(t, err, datetime.ErrInvalidFormat)
+	assert.ErrorIs(t, err, datetime.ErrOutOfRange)
+	assert.Equal(t, "unsupported format of \"2026-02-30\" at position 0, expected valid date time of pattern \"yyyy-MM-dd\": day must be between 1-28 get \"30\"", err.Error())
+}
+
+func TestSentinelErrors(t *testing.T) {
+	var d datetime.DateTime
+	var dd date.Date
+	var tm time.Time
+	var r date.Range
+
+	_, err := date.NewRangeStrict("", "")
+	assert.ErrorIs(t, err, datetime.ErrEmptyRange)
+	_, err = time.RangeFromString("[, ]")
+	assert.ErrorIs(t, err, datetime.ErrEmptyRange)
+	assert.ErrorIs(t, r.Scan("empty"), datetime.ErrEmptyRange)
+
+	assert.ErrorIs(t, d.Scan(nil), datetime.ErrNull)
+	assert.ErrorIs(t, dd.Scan(nil), datetime.ErrNull)
+	assert.ErrorIs(t, tm.Scan(nil), datetime.ErrNull)
+	assert.ErrorIs(t, d.Scan(1), datetime.ErrUnsupportedType)
+	assert.ErrorIs(t, dd.Scan(1.5), datetime.ErrUnsupportedType)
+	assert.ErrorIs(t, tm.Scan(goTime.Second), datetime.ErrUnsupportedType)
+
+	assert.ErrorIs(t, d.UnmarshalBinary([]byte{2}), datetime.ErrInvalidBinary)
+	assert.ErrorIs(t, dd.UnmarshalBinary(nil), datetime.ErrInvalidBinary)
+	assert.ErrorIs(t, tm.UnmarshalBinary([]byte{1, 2}), datetime.ErrInvalidBinary)
+
+	_, err = locale.Get("xx")
+	assert.ErrorIs(t, err, locale.ErrUnsupportedLocale)
+
+	_, err = datetime.ParseAny("10/11/2026", datetime.ParseAnyOptions{})
+	assert.ErrorIs(t, err, datetime.ErrAmbiguousDate)
+	assert.False(t, errors.Is(err, datetime.ErrInvalidFormat))
+}
@@ -0,0 +1,142 @@
+package tests
+
+import (
+	"fmt"
+	"github.com/gouef/datetime"
+	"github.com/gouef/datetime/date"
+	"github.com/gouef/datetime/time"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	goTime "time"
+)
+
+func TestFormat(t *testing.T) {
+	d, _ := datetime.New(2026, 10, 18, 14, 5, 9)
+
+	tests := []struct {
+		pattern  datetime.Pattern
+		expected string
+	}{
+		{datetime.Strftime("%Y-%m-%d %H:%M:%S"), "2026-10-18 14:05:09"},
+		{datetime.Strftime("%F %T"), "2026-10-18 14:05:09"},
+		{datetime.Strftime("%a, %e %b %Y %I:%M %p"), "Sun, 18 Oct 2026 02:05 PM"},
+		{datetime.Strftime("%A %B %-d, %j %% %z"), "Sunday October 18, 291 % +0000"},
+		{datetime.Strftime("%D %-H:%M"), "10/18/26 14:05"},
+		{datetime.PHP("Y-m-d H:i:s"), "2026-10-18 14:05:09"},
+		{datetime.PHP("l jS F Y g:i a"), "Sunday 18th October 2026 2:05 pm"},
+		{datetime.PHP("D, d M y \\a\\t G\\h"), "Sun, 18 Oct 26 at 14h"},
+		{datetime.PHP("c"), "2026-10-18T14:05:09+00:00"},
+		{datetime.PHP("N w z t L W"), "7 0 290 31 0 42"},
+		{datetime.PHP("U"), "1792332309"},
+		{datetime.ICU("yyyy-MM-dd HH:mm:ss"), "2026-10-18 14:05:09"},
+		{datetime.ICU("EEEE, d. MMMM yy"), "Sunday, 18. October 26"},
+		{datetime.ICU("EEE MMM d h:mm a"), "Sun Oct 18 2:05 PM"},
+		{datetime.ICU("h 'o''clock' a, XXX"), "2 o'clock PM, Z"},
+		{datetime.ICU("yyyy-MM-dd'T'HH:mm:ssxxx"), "2026-10-18T14:05:09+00:00"},
+	}
+
+	for _, tt := range tests {
+		t.Run("Format: "+tt.pattern.Layout, func(t *testing.T) {
+			assert.Equal(t, tt.expected, d.Format(tt.pattern))
+		})
+	}
+
+	t.Run("Format date and time", func(t *testing.T) {
+		dateValue, _ := date.New(2026, 3, 1)
+		timeValue, _ := time.New(9, 7, 0)
+
+		assert.Equal(t, "01.03.2026", dateValue.(*date.Date).Format(datetime.PHP("d.m.Y")))
+		assert.Equal(t, "9:07 AM", timeValue.(*time.Time).Format(datetime.ICU("h:mm a")))
+	})
+
+	t.Run("Format many layouts", func(t *testing.T) {
+		// compiled patterns are cached, layouts evicted from cache are compiled again
+		for i := range 1000 {
+			assert.Equal(t, fmt.Sprintf("%d 2026", i), d.Format(datetime.Strftime(fmt.Sprintf("%d %%Y", i))))
+		}
+
+		assert.Equal(t, "0 2026", d.Format(datetime.Strftime("0 %Y")))
+	})
+
+	t.Run("FormatTime offset", func(t *testing.T) {
+		value := goTime.Date(2026, 10, 18, 14, 5, 9, 0, goTime.FixedZone("CEST", 2*3600))
+
+		assert.Equal(t, "+0200 +02:00 CEST", datetime.FormatTime(value, datetime.PHP("O P T")))
+	})
+}
+
+func TestParse(t *testing.T) {
+	expected, _ := datetime.New(2026, 10, 18, 14, 5, 9)
+
+	tests := []struct {
+		pattern     datetime.Pattern
+		value       string
+		expectedErr bool
+	}{
+		{datetime.Strftime("%Y-%m-%d %H:%M:%S"), "2026-10-18 14:05:09", false},
+		{datetime.Strftime("%d/%m/%Y %I:%M:%S %p"), "18/10/2026 02:05:09 pm", false},
+		{datetime.Strftime("%a %b %e %T %Y"), "Sun Oct 18 14:05:09 2026", false},
+		{datetime.Strftime("%Y-%m-%dT%H:%M:%S%z"), "2026-10-18T16:05:09+0200", false},
+		{datetime.Strftime("%s"), "1792332309", false},
+		{datetime.PHP("j.n.Y G:i:s"), "18.10.2026 14:05:09", false},
+		{datetime.PHP("l, jS F Y H:i:s"), "Sunday, 18th October 2026 14:05:09", false},
+		{datetime.PHP("c"), "2026-10-18T14:05:09+00:00", false},
+		{datetime.PHP("Y z H:i:s"), "2026 290 14:05:09", false},
+		{datetime.ICU("yyyy-MM-dd HH:mm:ss"), "2026-10-18 14:05:09", false},
+		{datetime.ICU("d MMMM yyyy, HH:mm:ss"), "18 october 2026, 14:05:09", false},
+		{datetime.ICU("yyyy-MM-dd'T'HH:mm:ssXXX"), "2026-10-18T14:05:09Z", false},
+		{datetime.ICU("yyyy-MM-dd HH:mm:ss"), "2026-10-18 14:05", true},
+		{datetime.ICU("yyyy-MM-dd HH:mm:ss"), "2026-02-30 14:05:09", true},
+		{datetime.PHP("Y-m-d H:i:s"), "2026-10-18 24:05:09", true},
+		{datetime.PHP("Y-m-d"), "2026-10-18 trailing", true},
+		{datetime.Strftime("%B"), "Octember", true},
+	}
+
+	for _, tt := range tests {
+		t.Run("Parse: "+tt.value, func(t *testing.T) {
+			d, err := datetime.Parse(tt.pattern, tt.value)
+
+			if tt.expectedErr {
+				assert.Error(t, err)
+				assert.Nil(t, d)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, expected, d)
+			}
+		})
+	}
+
+	t.Run("Parse date", func(t *testing.T) {
+		expectedDate, _ := date.New(2026, 10, 18)
+		d, err := date.Parse(datetime.ICU("dd.MM.yyyy"), "18.10.2026")
+
+		assert.NoError(t, err)
+		assert.Equal(t, expectedDate, d)
+
+		d, err = date.Parse(datetime.ICU("dd.MM.yyyy"), "31.11.2026")
+
+		assert.Error(t, err)
+		assert.Nil(t, d)
+	})
+
+	t.Run("Parse time", func(t *testing.T) {
+		expectedTime, _ := time.New(21, 30, 0)
+		tm, err := time.Parse(datetime.PHP("g:i A"), "9:30 PM")
+
+		assert.NoError(t, err)
+		assert.Equal(t, expectedTime, tm)
+
+		tm, err = time.Parse(datetime.PHP("g:i A"), "13:30 PM")
+
+		assert.Error(t, err)
+		assert.Nil(t, tm)
+	})
+
+	t.Run("ParseTime location", func(t *testing.T) {
+		parsed, err := datetime.ParseTime(datetime.PHP("Y-m-d H:i e"), "2026-10-18 14:05 Europe/Prague")
+
+		assert.NoError(t, err)
+		assert.Equal(t, "Europe/Prague", parsed.Location().String())
+		assert.Equal(t, 12, parsed.UTC().Hour())
+	})
+}
@@ -0,0 +1,95 @@
+package tests
+
+import (
+	"github.com/gouef/datetime"
+	"github.com/gouef/datetime/locale"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestHumanize(t *testing.T) {
+	reference, _ := datetime.New(2026, 10, 18, 12, 0, 0)
+
+	tests := []struct {
+		tag      string
+		offset   time.Duration
+		expected string
+	}{
+		{"en", -3 * time.Hour, "3 hours ago"},
+		{"en", 48 * time.Hour, "in 2 days"},
+		{"en", 5 * time.Second, "now"},
+		{"en", -30 * time.Second, "30 seconds ago"},
+		{"en", 50 * time.Second, "in 1 minute"},
+		{"en", 23 * time.Hour, "in 1 day"},
+		{"en", 40 * 24 * time.Hour, "in 1 month"},
+		{"en", -400 * 24 * time.Hour, "1 year ago"},
+		{"cs", -3 * time.Hour, "před 3 hodinami"},
+		{"cs", -time.Hour, "před 1 hodinou"},
+		{"cs", 48 * time.Hour, "za 2 dny"},
+		{"cs", 5 * 24 * time.Hour, "za 5 dní"},
+		{"cs", -5 * 24 * time.Hour, "před 5 dny"},
+		{"sk", 3 * time.Hour, "o 3 hodiny"},
+		{"pl", -2 * time.Hour, "2 godziny temu"},
+		{"pl", 5 * time.Hour, "za 5 godzin"},
+		{"pl", 22 * 24 * time.Hour, "za 22 dni"},
+		{"de", -2 * 24 * time.Hour, "vor 2 Tagen"},
+		{"fr", -2 * time.Minute, "il y a 2 minutes"},
+	}
+
+	for _, tt := range tests {
+		t.Run("Humanize: "+tt.expected, func(t *testing.T) {
+			moved := reference.Time().Add(tt.offset)
+			value, _ := datetime.New(moved.Year(), int(moved.Month()), moved.Day(), moved.Hour(), moved.Minute(), moved.Second())
+
+			assert.Equal(t, tt.expected, datetime.NewHumanizer(locale.MustGet(tt.tag)).Humanize(value, reference))
+		})
+	}
+
+	t.Run("Humanize default", func(t *testing.T) {
+		value, _ := datetime.New(2026, 10, 18, 9, 0, 0)
+
+		assert.Equal(t, "3 hours ago", datetime.Humanize(value, reference))
+	})
+}
+
+func TestHumanizeDuration(t *testing.T) {
+	tests := []struct {
+		tag      string
+		duration time.Duration
+		expected string
+	}{
+		{"en", 2 * 24 * time.Hour, "2 days"},
+		{"en", time.Minute, "1 minute"},
+		{"cs", 2 * 24 * time.Hour, "2 dny"},
+		{"cs", 5 * 24 * time.Hour, "5 dní"},
+		{"cs", 24 * time.Hour, "1 den"},
+		{"pl", 2 * 24 * time.Hour, "2 dni"},
+		{"pl", 5 * 30 * 24 * time.Hour, "5 miesięcy"},
+		{"pl", 3 * 30 * 24 * time.Hour, "3 miesiące"},
+		{"pl", -2 * 365 * 24 * time.Hour, "2 lata"},
+		{"cs", 5 * 365 * 24 * time.Hour, "5 let"},
+	}
+
+	for _, tt := range tests {
+		t.Run("HumanizeDuration: "+tt.expected, func(t *testing.T) {
+			assert.Equal(t, tt.expected, datetime.NewHumanizer(locale.MustGet(tt.tag)).Duration(tt.duration))
+		})
+	}
+
+	t.Run("Thresholds and rounding", func(t *testing.T) {
+		h := datetime.NewHumanizer(locale.MustGet("en"))
+		h.Thresholds.Day = 7
+		h.Thresholds.Week = 5
+
+		assert.Equal(t, "2 weeks", h.Duration(15*24*time.Hour))
+
+		h.Rounding = datetime.RoundDown
+		assert.Equal(t, "1 hour", h.Duration(119*time.Minute))
+
+		h.Rounding = datetime.RoundUp
+		assert.Equal(t, "2 hours", h.Duration(61*time.Minute))
+
+		assert.Equal(t, "2 days", datetime.HumanizeDuration(-48*time.Hour))
+	})
+}
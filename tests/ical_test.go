@@ -0,0 +1,364 @@
+package tests
+
+import (
+	"github.com/gouef/datetime"
+	"github.com/gouef/datetime/date"
+	"github.com/gouef/datetime/ical"
+	"github.com/gouef/datetime/rrule"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestCalendarString(t *testing.T) {
+	datetime.SetClock(datetime.NewFakeClock(time.Date(2026, 10, 18, 14, 5, 9, 0, time.UTC)))
+	defer datetime.SetClock(nil)
+
+	prague, err := time.LoadLocation("Europe/Prague")
+	require.NoError(t, err)
+
+	days, err := date.NewRangeStrict("2026-12-24", "2026-12-26")
+	require.NoError(t, err)
+	christmas, err := ical.NewAllDayEvent("Christmas", days)
+	require.NoError(t, err)
+	christmas.UID = "christmas@example.com"
+
+	hours, err := datetime.NewRangeStartStrict("2026-10-19 09:00:00", "2026-10-19 17:00:00")
+	require.NoError(t, err)
+	shift, err := ical.NewEvent("Shift, early; A", hours, prague)
+	require.NoError(t, err)
+	shift.UID = "shift"
+
+	c := &ical.Calendar{Name: "Holidays", Events: []ical.Event{christmas, shift}}
+
+	expected := strings.Join([]string{
+		"BEGIN:VCALENDAR",
+		"VERSION:2.0",
+		"PRODID:-//gouef//datetime//EN",
+		"CALSCALE:GREGORIAN",
+		"X-WR-CALNAME:Holidays",
+		"BEGIN:VEVENT",
+		"UID:christmas@example.com",
+		"DTSTAMP:20261018T140509Z",
+		"DTSTART;VALUE=DATE:20261224",
+		"DTEND;VALUE=DATE:20261227",
+		"SUMMARY:Christmas",
+		"END:VEVENT",
+		"BEGIN:VEVENT",
+		"UID:shift",
+		"DTSTAMP:20261018T140509Z",
+		"DTSTART;TZID=Europe/Prague:20261019T090000",
+		"DTEND;TZID=Europe/Prague:20261019T170000",
+		"SUMMARY:Shift\\, early\\; A",
+		"END:VEVENT",
+		"END:VCALENDAR",
+		"",
+	}, "\r\n")
+
+	assert.Equal(t, expected, c.String())
+
+	var b strings.Builder
+	n, err := c.WriteTo(&b)
+	require.NoError(t, err)
+	assert.Equal(t, int64(len(expected)), n)
+	assert.Equal(t, expected, b.String())
+}
+
+func TestEventDaylightSavingTime(t *testing.T) {
+	tests := []struct {
+		name     string
+		zone     string
+		from     string
+		expected string
+	}{
+		// 02:00 to 03:00 is skipped, offset before the gap is used
+		{"spring forward", "America/New_York", "2026-03-08 02:30:00", "2026-03-08T03:30:00-04:00"},
+		{"spring forward eastward", "Europe/Prague", "2026-03-29 02:30:00", "2026-03-29T03:30:00+02:00"},
+		// 02:00 to 03:00 is repeated, the first occurrence is used
+		{"fall back", "America/New_York", "2026-11-01 01:30:00", "2026-11-01T01:30:00-04:00"},
+		{"fall back eastward", "Europe/Prague", "2026-10-25 02:30:00", "2026-10-25T02:30:00+02:00"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			loc, err := time.LoadLocation(tt.zone)
+			require.NoError(t, err)
+
+			r, err := datetime.NewRangeStrict(tt.from, tt.from)
+			require.NoError(t, err)
+			event, err := ical.NewEvent("Night", r, loc)
+			require.NoError(t, err)
+
+			// the same wall clock is the same instant in events and recurrence rules
+			parsed, err := rrule.ParseTime(strings.NewReplacer("-", "", ":", "", " ", "T").Replace(tt.from), loc)
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.expected, event.Start.Format(time.RFC3339))
+			assert.True(t, parsed.Equal(event.Start))
+		})
+	}
+}
+
+func TestCalendarFixedZone(t *testing.T) {
+	tests := []struct {
+		name string
+		loc  *time.Location
+	}{
+		{"named", time.FixedZone("UTC+3", 3*60*60)},
+		{"unnamed", time.FixedZone("", 3*60*60)},
+		// CET of IANA database is +02:00 in October
+		{"IANA name with other offset", time.FixedZone("CET", 3*60*60)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			event := ical.Event{
+				UID:   "fixed",
+				Start: time.Date(2026, 10, 19, 9, 0, 0, 0, tt.loc),
+				End:   time.Date(2026, 10, 19, 10, 0, 0, 0, tt.loc),
+				Stamp: time.Date(2026, 10, 18, 14, 5, 9, 0, time.UTC),
+			}
+
+			result := (&ical.Calendar{Events: []ical.Event{event}}).String()
+
+			assert.Contains(t, result, "\r\nDTSTART:20261019T060000Z\r\nDTEND:20261019T070000Z\r\n")
+			assert.NotContains(t, result, "TZID")
+		})
+	}
+}
+
+func TestCalendarStampInUTC(t *testing.T) {
+	tokyo, err := time.LoadLocation("Asia/Tokyo")
+	require.NoError(t, err)
+
+	datetime.SetClock(datetime.NewFakeClock(time.Date(2026, 10, 19, 18, 16, 4, 0, tokyo)))
+	defer datetime.SetClock(nil)
+
+	days, err := date.NewRangeStrict("2026-12-24", "2026-12-24")
+	require.NoError(t, err)
+	event, err := ical.NewAllDayEvent("Christmas Eve", days)
+	require.NoError(t, err)
+
+	c := &ical.Calendar{Events: []ical.Event{event}}
+
+	assert.Contains(t, c.String(), "\r\nDTSTAMP:20261019T091604Z\r\n")
+}
+
+func TestCalendarRoundTrip(t *testing.T) {
+	prague, err := time.LoadLocation("Europe/Prague")
+	require.NoError(t, err)
+
+	description := strings.Repeat("Příliš žluťoučký kůň úpěl ďábelské ódy.\n", 5)
+	c := &ical.Calendar{ProdID: "-//Example//Shifts//EN", Name: "Shifts, 2026", Events: []ical.Event{
+		{
+			Summary:     "Night",
+			Description: description,
+			Location:    "Hall\\B",
+			Start:       time.Date(2026, 10, 24, 22, 0, 0, 0, prague),
+			End:         time.Date(2026, 10, 25, 6, 0, 0, 0, prague),
+			Stamp:       time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			Summary: "Holiday",
+			Start:   time.Date(2026, 10, 28, 0, 0, 0, 0, time.UTC),
+			End:     time.Date(2026, 10, 29, 0, 0, 0, 0, time.UTC),
+			AllDay:  true,
+			Stamp:   time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC),
+		},
+	}}
+
+	text := c.String()
+
+	for _, line := range strings.Split(strings.TrimSuffix(text, "\r\n"), "\r\n") {
+		assert.LessOrEqual(t, len(line), 75, line)
+	}
+
+	parsed, err := ical.Parse(text)
+	require.NoError(t, err)
+
+	assert.Equal(t, c.ProdID, parsed.ProdID)
+	assert.Equal(t, c.Name, parsed.Name)
+	require.Len(t, parsed.Events, 2)
+
+	for i, e := range parsed.Events {
+		expected := c.Events[i]
+
+		assert.NotEmpty(t, e.UID)
+		assert.Equal(t, expected.Summary, e.Summary)
+		assert.Equal(t, expected.Description, e.Description)
+		assert.Equal(t, expected.Location, e.Location)
+		assert.Equal(t, expected.AllDay, e.AllDay)
+		assert.True(t, expected.Start.Equal(e.Start))
+		assert.True(t, expected.End.Equal(e.End))
+		assert.True(t, expected.Stamp.Equal(e.Stamp))
+		assert.Equal(t, expected.Start.Location().String(), e.Start.Location().String())
+	}
+
+	// UID derived from DTSTART and SUMMARY is stable
+	again, err := ical.Parse(c.String())
+	require.NoError(t, err)
+	assert.Equal(t, parsed.Events[0].UID, again.Events[0].UID)
+	assert.NotEqual(t, parsed.Events[0].UID, parsed.Events[1].UID)
+}
+
+func TestCalendarParse(t *testing.T) {
+	text := strings.Join([]string{
+		"BEGIN:VCALENDAR",
+		"VERSION:2.0",
+		"PRODID:-//Example//Holidays//EN",
+		"X-WR-CALNAME:Public holidays",
+		"BEGIN:VTIMEZONE",
+		"TZID:Europe/Prague",
+		"BEGIN:STANDARD",
+		"DTSTART:19701025T030000",
+		"TZOFFSETFROM:+0200",
+		"TZOFFSETTO:+0100",
+		"END:STANDARD",
+		"END:VTIMEZONE",
+		"BEGIN:VEVENT",
+		"UID:1@example.com",
+		"DTSTAMP:20260101T000000Z",
+		"DTSTART;VALUE=DATE:20261028",
+		"SUMMARY:Independent Czechoslovak State Day",
+		"END:VEVENT",
+		"BEGIN:VEVENT",
+		"UID:2@example.com",
+		"DTSTART;TZID=\"Europe/Prague\":20261024T120000",
+		"DURATION:P1D",
+		"SUMMARY:Weekend\\, shift\\; long",
+		"DESCRIPTION:First line\\nsecond line with a long text which is folded over",
+		"  two lines",
+		"BEGIN:VALARM",
+		"TRIGGER:-PT15M",
+		"DTSTART:20000101T000000Z",
+		"END:VALARM",
+		"END:VEVENT",
+		"BEGIN:VEVENT",
+		"UID:3@example.com",
+		"DTSTART:20261019T090000",
+		"DTEND:20261019T093000Z",
+		"SUMMARY:Stand-up",
+		"END:VEVENT",
+		"END:VCALENDAR",
+	}, "\r\n")
+
+	c, err := ical.Parse(text)
+	require.NoError(t, err)
+
+	assert.Equal(t, "-//Example//Holidays//EN", c.ProdID)
+	assert.Equal(t, "Public holidays", c.Name)
+	require.Len(t, c.Events, 3)
+
+	holiday := c.Events[0]
+	assert.True(t, holiday.AllDay)
+	assert.Equal(t, "Independent Czechoslovak State Day", holiday.Summary)
+	assert.Equal(t, "2026-10-28T00:00:00Z", holiday.Start.Format(time.RFC3339))
+	assert.Equal(t, "2026-10-29T00:00:00Z", holiday.End.Format(time.RFC3339))
+
+	days, err := holiday.Days()
+	require.NoError(t, err)
+	assert.Equal(t, "[2026-10-28, 2026-10-28]", days.String())
+
+	weekend := c.Events[1]
+	assert.False(t, weekend.AllDay)
+	assert.Equal(t, "Weekend, shift; long", weekend.Summary)
+	assert.Equal(t, "First line\nsecond line with a long text which is folded over two lines", weekend.Description)
+	assert.Equal(t, "Europe/Prague", weekend.Start.Location().String())
+	// the day of the end of daylight saving time has 25 hours
+	assert.Equal(t, 25*time.Hour, weekend.End.Sub(weekend.Start))
+
+	r, err := weekend.Range()
+	require.NoError(t, err)
+	assert.Equal(t, "[2026-10-24 12:00:00, 2026-10-25 12:00:00)", r.String())
+
+	days, err = weekend.Days()
+	require.NoError(t, err)
+	assert.Equal(t, "[2026-10-24, 2026-10-25]", days.String())
+
+	standUp := c.Events[2]
+	assert.Equal(t, 30*time.Minute, standUp.End.Sub(standUp.Start))
+	assert.Equal(t, time.UTC, standUp.Start.Location())
+}
+
+func TestCalendarParseDuration(t *testing.T) {
+	tests := []struct {
+		duration string
+		expected string
+	}{
+		{"PT1H30M", "2026-10-19T10:30:00Z"},
+		{"PT45S", "2026-10-19T09:00:45Z"},
+		{"P1W", "2026-10-26T09:00:00Z"},
+		{"P1DT2H", "2026-10-20T11:00:00Z"},
+		{"+P2D", "2026-10-21T09:00:00Z"},
+		{"P0D", "2026-10-19T09:00:00Z"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.duration, func(t *testing.T) {
+			c, err := ical.Parse(calendarOf("DTSTART:20261019T090000Z", "DURATION:"+tt.duration))
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.expected, c.Events[0].End.Format(time.RFC3339))
+		})
+	}
+}
+
+func TestCalendarParseErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		text string
+		err  error
+	}{
+		{"not calendar", "BEGIN:VEVENT\r\nEND:VEVENT", datetime.ErrInvalidFormat},
+		{"not closed", "BEGIN:VCALENDAR\r\nVERSION:2.0", datetime.ErrInvalidFormat},
+		{"wrong end", "BEGIN:VCALENDAR\r\nBEGIN:VEVENT\r\nEND:VCALENDAR", datetime.ErrInvalidFormat},
+		{"no colon", calendarOf("DTSTART"), datetime.ErrInvalidFormat},
+		{"unclosed quote", calendarOf("DTSTART;TZID=\"Europe/Prague:20261019T090000"), datetime.ErrInvalidFormat},
+		{"no start", calendarOf("SUMMARY:Nothing"), datetime.ErrInvalidFormat},
+		{"unknown time zone", calendarOf("DTSTART;TZID=Mars/Olympus:20261019T090000"), datetime.ErrInvalidFormat},
+		{"invalid date", calendarOf("DTSTART:20261319T090000"), datetime.ErrInvalidFormat},
+		{"hours in days", calendarOf("DTSTART:20261019T090000Z", "DURATION:P1H"), datetime.ErrInvalidFormat},
+		{"no units", calendarOf("DTSTART:20261019T090000Z", "DURATION:P1DT"), datetime.ErrInvalidFormat},
+		{"no designator", calendarOf("DTSTART:20261019T090000Z", "DURATION:1D"), datetime.ErrInvalidFormat},
+		{"negative duration", calendarOf("DTSTART:20261019T090000Z", "DURATION:-PT1H"), ical.ErrInvalidEvent},
+		{"end before start", calendarOf("DTSTART:20261019T090000Z", "DTEND:20261018T090000Z"), ical.ErrInvalidEvent},
+		{"mixed values", calendarOf("DTSTART;VALUE=DATE:20261019", "DTEND:20261020T090000Z"), ical.ErrInvalidEvent},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ical.Parse(tt.text)
+
+			assert.ErrorIs(t, err, tt.err)
+		})
+	}
+}
+
+func TestNewEventErrors(t *testing.T) {
+	unbounded, err := datetime.NewRangeStrict("2026-10-19 09:00:00", "")
+	require.NoError(t, err)
+	_, err = ical.NewEvent("Open", unbounded, nil)
+	assert.ErrorIs(t, err, date.ErrRangeWithoutTo)
+
+	unbounded, err = datetime.NewRangeStrict("", "2026-10-19 09:00:00")
+	require.NoError(t, err)
+	_, err = ical.NewEvent("Open", unbounded, nil)
+	assert.ErrorIs(t, err, date.ErrUnboundedRange)
+
+	reversed, err := datetime.NewRangeStrict("2026-10-19 09:00:00", "2026-10-18 09:00:00")
+	require.NoError(t, err)
+	_, err = ical.NewEvent("Reversed", reversed, nil)
+	assert.ErrorIs(t, err, ical.ErrInvalidEvent)
+
+	days, err := date.NewRangeStrict("2026-10-19", "")
+	require.NoError(t, err)
+	_, err = ical.NewAllDayEvent("Open", days)
+	assert.ErrorIs(t, err, date.ErrRangeWithoutTo)
+}
+
+// calendarOf returns VCALENDAR with single VEVENT of lines
+func calendarOf(lines ...string) string {
+	return "BEGIN:VCALENDAR\r\nBEGIN:VEVENT\r\n" + strings.Join(lines, "\r\n") + "\r\nEND:VEVENT\r\nEND:VCALENDAR\r\n"
+}
@@ -0,0 +1,89 @@
+package tests
+
+import (
+	"github.com/gouef/datetime"
+	"github.com/gouef/datetime/date"
+	"github.com/gouef/datetime/locale"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestLocale(t *testing.T) {
+	t.Run("Get", func(t *testing.T) {
+		cs, err := locale.Get("cs_CZ")
+
+		assert.NoError(t, err)
+		assert.Equal(t, "cs", cs.Tag)
+
+		_, err = locale.Get("xx")
+		assert.Error(t, err)
+
+		assert.Equal(t, []string{"cs", "de", "en", "fr", "pl", "sk"}, locale.Available())
+	})
+
+	t.Run("Names", func(t *testing.T) {
+		cs := locale.MustGet("cs")
+
+		assert.Equal(t, "října", cs.MonthName(time.October, locale.ContextFormat, locale.WidthWide))
+		assert.Equal(t, "říjen", cs.MonthName(time.October, locale.ContextStandalone, locale.WidthWide))
+		assert.Equal(t, "ne", cs.WeekdayName(time.Sunday, locale.ContextFormat, locale.WidthAbbreviated))
+		assert.Equal(t, "EEEE d. MMMM y H:mm", cs.DateTimeFormat(locale.StyleFull, locale.StyleShort))
+	})
+}
+
+func TestFormatLocale(t *testing.T) {
+	d, _ := datetime.New(2026, 10, 18, 14, 5, 9)
+
+	tests := []struct {
+		pattern  datetime.Pattern
+		expected string
+	}{
+		{datetime.DateStyle(locale.MustGet("cs"), locale.StyleLong), "18. října 2026"},
+		{datetime.DateStyle(locale.MustGet("cs"), locale.StyleMedium), "18. 10. 2026"},
+		{datetime.DateStyle(locale.MustGet("de"), locale.StyleFull), "Sonntag, 18. Oktober 2026"},
+		{datetime.DateStyle(locale.MustGet("pl"), locale.StyleLong), "18 października 2026"},
+		{datetime.DateStyle(locale.MustGet("en"), locale.StyleFull), "Sunday, October 18, 2026"},
+		{datetime.DateTimeStyle(locale.MustGet("fr"), locale.StyleShort, locale.StyleShort), "18/10/2026 14:05"},
+		{datetime.TimeStyle(locale.MustGet("en"), locale.StyleMedium), "2:05:09 PM"},
+		{datetime.ICU("LLLL y").In(locale.MustGet("cs")), "říjen 2026"},
+		{datetime.Strftime("%OB %Y, %A").In(locale.MustGet("sk")), "október 2026, nedeľa"},
+		{datetime.PHP("j. F Y").In(locale.MustGet("sk")), "18. októbra 2026"},
+	}
+
+	for _, tt := range tests {
+		t.Run("Format locale: "+tt.expected, func(t *testing.T) {
+			assert.Equal(t, tt.expected, d.Format(tt.pattern))
+		})
+	}
+}
+
+func TestParseLocale(t *testing.T) {
+	expected, _ := date.New(2026, 10, 18)
+
+	tests := []struct {
+		pattern     datetime.Pattern
+		value       string
+		expectedErr bool
+	}{
+		{datetime.DateStyle(locale.MustGet("cs"), locale.StyleMedium), "18. 10. 2026", false},
+		{datetime.DateStyle(locale.MustGet("cs"), locale.StyleLong), "18. října 2026", false},
+		{datetime.DateStyle(locale.MustGet("cs"), locale.StyleLong), "18. říjen 2026", false},
+		{datetime.DateStyle(locale.MustGet("de"), locale.StyleFull), "sonntag, 18. Oktober 2026", false},
+		{datetime.DateStyle(locale.MustGet("pl"), locale.StyleMedium), "18 paź 2026", false},
+		{datetime.DateStyle(locale.MustGet("cs"), locale.StyleLong), "18. October 2026", true},
+	}
+
+	for _, tt := range tests {
+		t.Run("Parse locale: "+tt.value, func(t *testing.T) {
+			d, err := date.Parse(tt.pattern, tt.value)
+
+			if tt.expectedErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, expected, d)
+			}
+		})
+	}
+}
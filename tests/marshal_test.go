@@ -0,0 +1,168 @@
+package tests
+
+import (
+	"encoding/json"
+	"github.com/gouef/datetime"
+	"github.com/gouef/datetime/date"
+	"github.com/gouef/datetime/time"
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/yaml.v3"
+	"testing"
+)
+
+type marshalPayload struct {
+	Created  datetime.DateTime  `json:"created"`
+	Birthday date.Date          `json:"birthday"`
+	Opens    time.Time          `json:"opens"`
+	Updated  *datetime.DateTime `json:"updated"`
+	Window   datetime.Range     `json:"window"`
+	Days     date.Range         `json:"days"`
+	Hours    time.Range         `json:"hours"`
+	Value    datetime.Value     `json:"value"`
+	DayValue date.Value         `json:"dayValue"`
+	Time     time.Value         `json:"time"`
+}
+
+func TestMarshalJSON(t *testing.T) {
+	input := `{"created":"2026-10-18 14:05:09","birthday":"1990-02-28","opens":"08:30:00","updated":null,` +
+		`"window":"[2026-01-01 00:00:00, 2026-12-31 23:59:59]","days":"[2026-12-24, 2026-12-26]",` +
+		`"hours":"[09:00:00, 17:30:00)","value":"2026-10-18 14:05:09","dayValue":"2026-10-18","time":"17:30:00"}`
+
+	var payload marshalPayload
+
+	assert.NoError(t, json.Unmarshal([]byte(input), &payload))
+
+	created, _ := datetime.New(2026, 10, 18, 14, 5, 9)
+	birthday, _ := date.New(1990, 2, 28)
+	opens, _ := time.New(8, 30, 0)
+	window, _ := datetime.NewRangeStrict("2026-01-01 00:00:00", "2026-12-31 23:59:59")
+	days, _ := date.NewRangeStrict("2026-12-24", "2026-12-26")
+
+	assert.Equal(t, *created, payload.Created)
+	assert.Equal(t, birthday, &payload.Birthday)
+	assert.Equal(t, opens, &payload.Opens)
+	assert.Nil(t, payload.Updated)
+	assert.Equal(t, *window, payload.Window)
+	assert.Equal(t, *days, payload.Days)
+	assert.Equal(t, "[09:00:00, 17:30:00)", payload.Hours.String())
+	assert.Equal(t, datetime.Value("2026-10-18 14:05:09"), payload.Value)
+
+	output, err := json.Marshal(payload)
+
+	assert.NoError(t, err)
+	assert.JSONEq(t, input, string(output))
+
+	invalid := []string{
+		`{"created":"2026-02-30 14:05:09"}`,
+		`{"birthday":"1990-13-01"}`,
+		`{"opens":"25:00:00"}`,
+		`{"window":"[2026-01-01, 2026-12-31]"}`,
+		`{"days":"2026-12-24"}`,
+		`{"hours":"[09:00:00 - 17:00:00]"}`,
+		`{"value":"2026-10-18"}`,
+		`{"dayValue":"2026-10-32"}`,
+		`{"time":"17:60:00"}`,
+		`{"created":20261018}`,
+	}
+
+	for _, data := range invalid {
+		t.Run("Invalid JSON: "+data, func(t *testing.T) {
+			var p marshalPayload
+			assert.Error(t, json.Unmarshal([]byte(data), &p))
+		})
+	}
+}
+
+type rfc3339 struct{}
+
+func (rfc3339) Pattern() datetime.Pattern { return datetime.ICU("yyyy-MM-dd'T'HH:mm:ssXXX") }
+
+type czechDate struct{}
+
+func (czechDate) Pattern() datetime.Pattern { return datetime.PHP("d.m.Y") }
+
+type shortTime struct{}
+
+func (shortTime) Pattern() datetime.Pattern { return datetime.Strftime("%H:%M") }
+
+type formattedPayload struct {
+	Created  datetime.Formatted[rfc3339] `json:"created" yaml:"created"`
+	Updated  datetime.DateTime           `json:"updated" yaml:"updated"`
+	Birthday date.Formatted[czechDate]   `json:"birthday" yaml:"birthday"`
+	Opens    time.Formatted[shortTime]   `json:"opens" yaml:"opens"`
+}
+
+func TestMarshalFormatted(t *testing.T) {
+	created, _ := datetime.New(2026, 10, 18, 14, 5, 9)
+	birthday, _ := date.New(1990, 2, 28)
+	opens, _ := time.New(8, 30, 0)
+
+	payload := formattedPayload{
+		Created:  datetime.Formatted[rfc3339]{DateTime: *created},
+		Updated:  *created,
+		Birthday: date.Formatted[czechDate]{Date: *birthday.(*date.Date)},
+		Opens:    time.Formatted[shortTime]{Time: *opens.(*time.Time)},
+	}
+
+	data, err := json.Marshal(payload)
+	assert.NoError(t, err)
+	assert.Equal(t, `{"created":"2026-10-18T14:05:09Z","updated":"2026-10-18 14:05:09","birthday":"28.02.1990","opens":"08:30"}`, string(data))
+
+	var parsed formattedPayload
+	assert.NoError(t, json.Unmarshal([]byte(`{"created":"2026-10-18T16:05:09+02:00","updated":"2026-10-18 14:05:09","birthday":"28.02.1990","opens":"08:30"}`), &parsed))
+	assert.Equal(t, payload, parsed)
+
+	text, err := yaml.Marshal(payload)
+	assert.NoError(t, err)
+	assert.Equal(t, "created: \"2026-10-18T14:05:09Z\"\nupdated: \"2026-10-18 14:05:09\"\nbirthday: 28.02.1990\nopens: \"08:30\"\n", string(text))
+
+	parsed = formattedPayload{}
+	assert.NoError(t, yaml.Unmarshal(text, &parsed))
+	assert.Equal(t, payload, parsed)
+
+	assert.Error(t, json.Unmarshal([]byte(`{"birthday":"1990-02-28"}`), &parsed))
+	assert.Error(t, json.Unmarshal([]byte(`{"created":"2026-10-18 14:05:09"}`), &parsed))
+
+	var d date.Date
+	assert.NoError(t, d.UnmarshalTextPattern(datetime.PHP("d.m.Y"), []byte("28.02.1990")))
+	assert.Equal(t, birthday, &d)
+
+	data, err = d.MarshalTextPattern(datetime.ICU("d. M. yyyy"))
+	assert.NoError(t, err)
+	assert.Equal(t, "28. 2. 1990", string(data))
+}
+
+func TestMarshalBinary(t *testing.T) {
+	created, _ := datetime.New(2026, 10, 18, 14, 5, 9)
+	birthday, _ := date.New(1990, 2, 28)
+	opens, _ := time.New(8, 30, 0)
+	days, _ := date.NewRangeStrict("2026-12-24", "2026-12-26")
+
+	data, err := created.MarshalBinary()
+	assert.NoError(t, err)
+	var d datetime.DateTime
+	assert.NoError(t, d.UnmarshalBinary(data))
+	assert.Equal(t, *created, d)
+
+	data, err = birthday.(*date.Date).MarshalBinary()
+	assert.NoError(t, err)
+	var b date.Date
+	assert.NoError(t, b.UnmarshalBinary(data))
+	assert.Equal(t, birthday, &b)
+
+	data, err = opens.(*time.Time).MarshalBinary()
+	assert.NoError(t, err)
+	var o time.Time
+	assert.NoError(t, o.UnmarshalBinary(data))
+	assert.Equal(t, opens, &o)
+
+	data, err = days.MarshalBinary()
+	assert.NoError(t, err)
+	var r date.Range
+	assert.NoError(t, r.UnmarshalBinary(data))
+	assert.Equal(t, *days, r)
+
+	assert.Error(t, d.UnmarshalBinary([]byte{2, 0}))
+	assert.Error(t, b.UnmarshalBinary([]byte{1, 0, 0, 7, 234, 2, 30}))
+	assert.Error(t, o.UnmarshalBinary([]byte{1, 24, 0, 0}))
+}
@@ -0,0 +1,95 @@
+package tests
+
+import (
+	"errors"
+	"github.com/gouef/datetime"
+	"github.com/gouef/datetime/locale"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestParseAny(t *testing.T) {
+	tests := []struct {
+		value       string
+		options     datetime.ParseAnyOptions
+		expected    string
+		layout      string
+		ambiguous   bool
+		expectedErr bool
+	}{
+		{"18/10/2026", datetime.ParseAnyOptions{}, "2026-10-18 00:00:00", "day first slash date", false, false},
+		{"10/18/2026", datetime.ParseAnyOptions{}, "2026-10-18 00:00:00", "month first slash date", false, false},
+		{"18.10.2026", datetime.ParseAnyOptions{}, "2026-10-18 00:00:00", "dot date", false, false},
+		{" 18.10.2026 14:05 ", datetime.ParseAnyOptions{}, "2026-10-18 14:05:00", "dot date time minutes", false, false},
+		{"2026-10-18T14:05:09+02:00", datetime.ParseAnyOptions{}, "2026-10-18 12:05:09", "ISO 8601", false, false},
+		{"2026-10-18T14:05:09", datetime.ParseAnyOptions{}, "2026-10-18 14:05:09", "ISO 8601 local", false, false},
+		{"Sun, 18 Oct 2026 14:05:09 GMT", datetime.ParseAnyOptions{}, "2026-10-18 14:05:09", "RFC 1123", false, false},
+		{"1792332309", datetime.ParseAnyOptions{}, "2026-10-18 14:05:09", "unix", false, false},
+		{"46313", datetime.ParseAnyOptions{}, "2026-10-18 00:00:00", "excel", false, false},
+		{"46313.5", datetime.ParseAnyOptions{}, "2026-10-18 12:00:00", "excel", false, false},
+		{"46313", datetime.ParseAnyOptions{Numeric: datetime.NumericUnix}, "1970-01-01 12:51:53", "unix", false, false},
+		{"10/11/2026", datetime.ParseAnyOptions{Order: datetime.OrderDayFirst}, "2026-11-10 00:00:00", "day first slash date", true, false},
+		{"10/11/2026", datetime.ParseAnyOptions{Order: datetime.OrderMonthFirst}, "2026-10-11 00:00:00", "month first slash date", true, false},
+		{"10/11/2026", datetime.ParseAnyOptions{Locale: locale.MustGet("en")}, "2026-10-11 00:00:00", "month first slash date", true, false},
+		{"10/11/2026", datetime.ParseAnyOptions{Locale: locale.MustGet("cs")}, "2026-11-10 00:00:00", "day first slash date", true, false},
+		{"10/10/2026", datetime.ParseAnyOptions{}, "2026-10-10 00:00:00", "day first slash date", false, false},
+		{"18/10/2026", datetime.ParseAnyOptions{Layouts: []datetime.Layout{{Name: "custom", Pattern: datetime.PHP("d/m/Y")}}}, "2026-10-18 00:00:00", "custom", false, false},
+		{"60", datetime.ParseAnyOptions{}, "", "", false, true},
+		{"123456", datetime.ParseAnyOptions{}, "", "", false, true},
+		{"1792332309", datetime.ParseAnyOptions{Numeric: datetime.NumericNone}, "", "", false, true},
+		{"not a date", datetime.ParseAnyOptions{}, "", "", false, true},
+	}
+
+	for _, tt := range tests {
+		t.Run("ParseAny: "+tt.value, func(t *testing.T) {
+			result, err := datetime.ParseAny(tt.value, tt.options)
+
+			if tt.expectedErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expected, result.DateTime.ToString())
+				assert.Equal(t, tt.layout, result.Layout.Name)
+				assert.Equal(t, tt.ambiguous, result.Ambiguous)
+			}
+		})
+	}
+
+	t.Run("ParseAny ambiguous", func(t *testing.T) {
+		result, err := datetime.ParseAny("10/11/2026", datetime.ParseAnyOptions{})
+
+		assert.True(t, errors.Is(err, datetime.ErrAmbiguousDate))
+		assert.True(t, result.Ambiguous)
+		assert.Nil(t, result.DateTime)
+		assert.Len(t, result.Candidates, 2)
+	})
+}
+
+func TestDetectLayout(t *testing.T) {
+	tests := []struct {
+		values      []string
+		options     datetime.ParseAnyOptions
+		expected    string
+		expectedErr bool
+	}{
+		{[]string{"10/11/2026", "", "25/12/2026"}, datetime.ParseAnyOptions{}, "day first slash date", false},
+		{[]string{"10/11/2026", "12/25/2026"}, datetime.ParseAnyOptions{}, "month first slash date", false},
+		{[]string{"10/11/2026", "01/02/2026"}, datetime.ParseAnyOptions{Order: datetime.OrderMonthFirst}, "month first slash date", false},
+		{[]string{"10/11/2026", "01/02/2026"}, datetime.ParseAnyOptions{}, "", true},
+		{[]string{"1792332309", "1792332310"}, datetime.ParseAnyOptions{}, "unix", false},
+		{[]string{"2026-10-18", "18.10.2026"}, datetime.ParseAnyOptions{}, "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run("DetectLayout", func(t *testing.T) {
+			layout, err := datetime.DetectLayout(tt.values, tt.options)
+
+			if tt.expectedErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expected, layout.Name)
+			}
+		})
+	}
+}
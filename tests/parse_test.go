@@ -0,0 +1,120 @@
+package tests
+
+import (
+	"github.com/gouef/datetime"
+	"github.com/gouef/datetime/date"
+	"github.com/gouef/datetime/time"
+	"github.com/stretchr/testify/assert"
+	"regexp"
+	"testing"
+	goTime "time"
+)
+
+var parseInputs = []string{
+	"2026-10-18 14:05:09", "0001-01-01 00:00:00", "12026-10-18 14:05:09", "2026-10-18\t14:05:09",
+	"2026-10-18T14:05:09", "2026-10-18  14:05:09", "2026-10-18 14:05:09 ", " 2026-10-18 14:05:09",
+	"2026-00-18 14:05:09", "2026-13-18 14:05:09", "2026-10-00 14:05:09", "2026-10-32 14:05:09",
+	"2026-02-30 14:05:09", "2024-02-29 23:59:59", "2026-10-18 24:00:00", "2026-10-18 23:60:00",
+	"2026-10-18 23:59:60", "2026-1-18 14:05:09", "2026-10-18 4:05:09", "-2026-10-18 14:05:09",
+	"2026-10-18", "2026-10-18 ", "2026-10-1814:05:09", "2026-10-18 99:99:99", "2026-10-18 14:05",
+	"14:05:09", "x14:05:09", "123:45:67", "2026-10-18 14:05:09.123", "", "invalid", "2026-10-18 14:05:0x",
+}
+
+// TestParseStringCompatibility scanners accept the same values as regular expressions they replace
+func TestParseStringCompatibility(t *testing.T) {
+	tests := []struct {
+		name   string
+		regexp string
+		parse  func(string) error
+		// fields validates numbers the regular expression does not check
+		fields func(string) error
+	}{
+		{
+			"datetime", datetime.Regexp,
+			func(v string) error { _, err := datetime.ParseString(v); return err },
+			nil,
+		},
+		{
+			"date", date.DateTimeRegexp,
+			func(v string) error { _, err := date.ParseString(v); return err },
+			func(v string) error { _, err := date.New(atoi(v[0:4]), atoi(v[5:7]), atoi(v[8:10])); return err },
+		},
+		{
+			"time", time.DateTimeRegexp,
+			func(v string) error { _, err := time.ParseString(v); return err },
+			nil,
+		},
+	}
+
+	for _, tt := range tests {
+		re := regexp.MustCompile(tt.regexp)
+
+		for _, input := range parseInputs {
+			t.Run(tt.name+" "+input, func(t *testing.T) {
+				matches := re.MatchString(input)
+				err := tt.parse(input)
+
+				switch {
+				case !matches:
+					assert.ErrorIs(t, err, datetime.ErrInvalidFormat)
+				case tt.fields != nil && tt.fields(input) != nil:
+					assert.ErrorIs(t, err, datetime.ErrOutOfRange)
+				case tt.name == "datetime" && input == "2026-02-30 14:05:09":
+					assert.ErrorIs(t, err, datetime.ErrOutOfRange)
+				default:
+					assert.NoError(t, err)
+				}
+			})
+		}
+	}
+}
+
+func TestParseStringAllocations(t *testing.T) {
+	assert.Zero(t, testing.AllocsPerRun(100, func() { _, _ = datetime.ParseString("2026-10-18 14:05:09") }))
+	assert.Zero(t, testing.AllocsPerRun(100, func() { _, _ = date.ParseString("2026-10-18") }))
+	assert.Zero(t, testing.AllocsPerRun(100, func() { _, _ = time.ParseString("14:05:09") }))
+	assert.Zero(t, testing.AllocsPerRun(100, func() { _, _ = datetime.StringToValue("2026-10-18 14:05:09") }))
+	assert.Zero(t, testing.AllocsPerRun(100, func() { _, _ = date.StringToValue("2026-10-18") }))
+	assert.Zero(t, testing.AllocsPerRun(100, func() { _, _ = time.StringToValue("14:05:09") }))
+}
+
+func atoi(s string) int {
+	n := 0
+
+	for _, c := range s {
+		n = n*10 + int(c-'0')
+	}
+
+	return n
+}
+
+func BenchmarkParse(b *testing.B) {
+	benchmarks := []struct {
+		name  string
+		parse func()
+	}{
+		{"time.Parse", func() { _, _ = goTime.Parse(goTime.DateTime, "2026-10-18 14:05:09") }},
+		{"datetime.ParseString", func() { _, _ = datetime.ParseString("2026-10-18 14:05:09") }},
+		{"datetime.FromString", func() { _, _ = datetime.FromString("2026-10-18 14:05:09") }},
+		{"datetime.StringToValue", func() { _, _ = datetime.StringToValue("2026-10-18 14:05:09") }},
+		{"datetime.RangeFromString", func() {
+			_, _ = datetime.RangeFromString("[2026-10-18 14:05:09, 2026-10-19 14:05:09)")
+		}},
+		{"time.Parse date", func() { _, _ = goTime.Parse(goTime.DateOnly, "2026-10-18") }},
+		{"date.ParseString", func() { _, _ = date.ParseString("2026-10-18") }},
+		{"date.FromString", func() { _, _ = date.FromString("2026-10-18") }},
+		{"time.Parse time", func() { _, _ = goTime.Parse(goTime.TimeOnly, "14:05:09") }},
+		{"time.ParseString", func() { _, _ = time.ParseString("14:05:09") }},
+		{"time.FromString", func() { _, _ = time.FromString("14:05:09") }},
+	}
+
+	for _, bm := range benchmarks {
+		b.Run(bm.name, func(b *testing.B) {
+			b.ReportAllocs()
+
+			for i := 0; i < b.N; i++ {
+				bm.parse()
+			}
+		})
+	}
+}
@@ -0,0 +1,147 @@
+package tests
+
+import (
+	"github.com/gouef/datetime"
+	"github.com/gouef/datetime/time"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	goTime "time"
+)
+
+func TestDateTimePeriods(t *testing.T) {
+	// Sunday
+	d, _ := datetime.NewNano(2026, 10, 18, 14, 5, 9, 500000000)
+
+	tests := []struct {
+		name     string
+		value    datetime.DateTime
+		expected string
+	}{
+		{"start of day", d.StartOfDay(), "2026-10-18 00:00:00"},
+		{"end of day", d.EndOfDay(), "2026-10-18 23:59:59.999999999"},
+		{"start of week monday", d.StartOfWeek(goTime.Monday), "2026-10-12 00:00:00"},
+		{"start of week sunday", d.StartOfWeek(goTime.Sunday), "2026-10-18 00:00:00"},
+		{"end of week monday", d.EndOfWeek(goTime.Monday), "2026-10-18 23:59:59.999999999"},
+		{"end of week saturday", d.EndOfWeek(goTime.Saturday), "2026-10-23 23:59:59.999999999"},
+		{"start of month", d.StartOfMonth(), "2026-10-01 00:00:00"},
+		{"end of month", d.EndOfMonth(), "2026-10-31 23:59:59.999999999"},
+		{"start of quarter", d.StartOfQuarter(), "2026-10-01 00:00:00"},
+		{"end of quarter", d.EndOfQuarter(), "2026-12-31 23:59:59.999999999"},
+		{"start of year", d.StartOfYear(), "2026-01-01 00:00:00"},
+		{"end of year", d.EndOfYear(), "2026-12-31 23:59:59.999999999"},
+		{"floor 15 minutes", d.FloorToStep(15 * goTime.Minute), "2026-10-18 14:00:00"},
+		{"ceil 15 minutes", d.CeilToStep(15 * goTime.Minute), "2026-10-18 14:15:00"},
+		{"round 15 minutes", d.RoundToStep(15 * goTime.Minute), "2026-10-18 14:00:00"},
+		{"round 7 minutes from midnight", d.RoundToStep(7 * goTime.Minute), "2026-10-18 14:07:00"},
+		{"ceil past midnight", d.EndOfDay().CeilToStep(goTime.Hour), "2026-10-19 00:00:00"},
+		{"ceil exact", d.StartOfDay().CeilToStep(goTime.Hour), "2026-10-18 00:00:00"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, tt.value.ToString())
+		})
+	}
+
+	february, _ := datetime.New(2024, 2, 10, 0, 0, 0)
+	assert.Equal(t, "2024-02-29 23:59:59.999999999", february.EndOfMonth().ToString())
+	assert.Equal(t, "2024-01-01 00:00:00", february.StartOfQuarter().ToString())
+}
+
+func TestDateTimePeriodRanges(t *testing.T) {
+	d, _ := datetime.New(2026, 10, 18, 14, 5, 9)
+
+	tests := []struct {
+		name     string
+		value    *datetime.Range
+		expected string
+	}{
+		{"day", d.DayRange(), "[2026-10-18 00:00:00, 2026-10-18 23:59:59.999999999]"},
+		{"week", d.WeekRange(goTime.Monday), "[2026-10-12 00:00:00, 2026-10-18 23:59:59.999999999]"},
+		{"month", d.MonthRange(), "[2026-10-01 00:00:00, 2026-10-31 23:59:59.999999999]"},
+		{"quarter", d.QuarterRange(), "[2026-10-01 00:00:00, 2026-12-31 23:59:59.999999999]"},
+		{"year", d.YearRange(), "[2026-01-01 00:00:00, 2026-12-31 23:59:59.999999999]"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, tt.value.String())
+			assert.True(t, tt.value.Is(d))
+
+			parsed, err := datetime.RangeFromString(tt.expected)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.value, parsed)
+		})
+	}
+
+	assert.False(t, d.MonthRange().Is("2026-11-01 00:00:01"))
+}
+
+func TestDatePeriods(t *testing.T) {
+	// Sunday
+	d := newTestDate(2026, 10, 18)
+
+	tests := []struct {
+		name     string
+		value    string
+		expected string
+	}{
+		{"start of day", d.StartOfDay().ToString(), "2026-10-18 00:00:00"},
+		{"end of day", d.EndOfDay().ToString(), "2026-10-18 23:59:59.999999999"},
+		{"start of week", d.StartOfWeek(goTime.Monday).ToString(), "2026-10-12"},
+		{"end of week", d.EndOfWeek(goTime.Monday).ToString(), "2026-10-18"},
+		{"start of week sunday", d.StartOfWeek(goTime.Sunday).ToString(), "2026-10-18"},
+		{"end of week sunday", d.EndOfWeek(goTime.Sunday).ToString(), "2026-10-24"},
+		{"start of month", d.StartOfMonth().ToString(), "2026-10-01"},
+		{"end of month", d.EndOfMonth().ToString(), "2026-10-31"},
+		{"start of quarter", d.StartOfQuarter().ToString(), "2026-10-01"},
+		{"end of quarter", d.EndOfQuarter().ToString(), "2026-12-31"},
+		{"start of year", d.StartOfYear().ToString(), "2026-01-01"},
+		{"end of year", d.EndOfYear().ToString(), "2026-12-31"},
+		{"day range", d.DayRange().String(), "[2026-10-18 00:00:00, 2026-10-18 23:59:59.999999999]"},
+		{"week range", d.WeekRange(goTime.Monday).String(), "[2026-10-12, 2026-10-18]"},
+		{"month range", d.MonthRange().String(), "[2026-10-01, 2026-10-31]"},
+		{"quarter range", d.QuarterRange().String(), "[2026-10-01, 2026-12-31]"},
+		{"year range", d.YearRange().String(), "[2026-01-01, 2026-12-31]"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, tt.value)
+		})
+	}
+
+	assert.True(t, d.MonthRange().Is("2026-10-20"))
+}
+
+func TestTimeSteps(t *testing.T) {
+	tests := []struct {
+		value string
+		step  goTime.Duration
+		floor string
+		ceil  string
+		round string
+	}{
+		{"10:14:00", 15 * goTime.Minute, "10:00:00", "10:15:00", "10:15:00"},
+		{"10:07:29", 15 * goTime.Minute, "10:00:00", "10:15:00", "10:00:00"},
+		{"10:07:30", 15 * goTime.Minute, "10:00:00", "10:15:00", "10:15:00"},
+		{"10:15:00", 15 * goTime.Minute, "10:15:00", "10:15:00", "10:15:00"},
+		{"23:50:00", 15 * goTime.Minute, "23:45:00", "00:00:00", "23:45:00"},
+		{"23:53:00", 15 * goTime.Minute, "23:45:00", "00:00:00", "00:00:00"},
+		{"13:20:00", 90 * goTime.Minute, "12:00:00", "13:30:00", "13:30:00"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			value, err := time.ParseString(tt.value)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.floor, value.FloorToStep(tt.step).ToString())
+			assert.Equal(t, tt.ceil, value.CeilToStep(tt.step).ToString())
+			assert.Equal(t, tt.round, value.RoundToStep(tt.step).ToString())
+		})
+	}
+
+	start := goTime.Date(2026, 10, 18, 10, 14, 0, 0, goTime.FixedZone("CEST", 2*3600))
+	assert.Equal(t, goTime.Date(2026, 10, 18, 10, 0, 0, 0, start.Location()), datetime.FloorToStep(start, 15*goTime.Minute))
+	assert.Equal(t, goTime.Date(2026, 10, 12, 0, 0, 0, 0, start.Location()), datetime.StartOfWeek(start, goTime.Monday))
+}
@@ -0,0 +1,226 @@
+package tests
+
+import (
+	"encoding/json"
+	"github.com/gouef/datetime"
+	"github.com/gouef/datetime/date"
+	"github.com/gouef/datetime/time"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	goTime "time"
+)
+
+func TestParseFraction(t *testing.T) {
+	tests := []struct {
+		input      string
+		nanosecond int
+		expected   string
+	}{
+		{"2026-10-18 14:05:09", 0, "2026-10-18 14:05:09"},
+		{"2026-10-18 14:05:09.5", 500000000, "2026-10-18 14:05:09.5"},
+		{"2026-10-18 14:05:09.123", 123000000, "2026-10-18 14:05:09.123"},
+		{"2026-10-18 14:05:09.000120", 120000, "2026-10-18 14:05:09.00012"},
+		{"2026-10-18 14:05:09.123456789", 123456789, "2026-10-18 14:05:09.123456789"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			d, err := datetime.ParseString(tt.input)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.nanosecond, d.Nanosecond())
+			assert.Equal(t, tt.expected, d.ToString())
+
+			tm, err := time.ParseString(tt.input)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.nanosecond, tm.Nanosecond())
+			assert.Equal(t, tt.expected[11:], tm.ToString())
+
+			dd, err := date.ParseString(tt.input)
+			assert.NoError(t, err)
+			assert.Equal(t, "2026-10-18", dd.ToString())
+		})
+	}
+
+	_, err := datetime.ParseString("2026-10-18 14:05:09.")
+	var parseErr *datetime.ParseError
+	assert.ErrorAs(t, err, &parseErr)
+	assert.Equal(t, 20, parseErr.Position)
+	assert.Equal(t, "digits of fraction", parseErr.Expected)
+
+	_, err = datetime.ParseString("2026-10-18 14:05:09.1234567891")
+	assert.ErrorAs(t, err, &parseErr)
+	assert.Equal(t, 29, parseErr.Position)
+
+	_, err = date.ParseString("2026-10-18 14:05:09.")
+	assert.ErrorIs(t, err, datetime.ErrInvalidFormat)
+
+	// time is found anywhere in value, "." without digits is not part of it
+	tm, err := time.ParseString("at 14:05:09.")
+	assert.NoError(t, err)
+	assert.Equal(t, "14:05:09", tm.ToString())
+}
+
+func TestPrecisionFormat(t *testing.T) {
+	d, _ := datetime.NewNano(2026, 10, 18, 14, 5, 9, 123456789)
+	tm, _ := time.NewNano(14, 5, 9, 120000000)
+
+	tests := []struct {
+		precision datetime.Precision
+		datetime  string
+		time      string
+	}{
+		{datetime.PrecisionSecond, "2026-10-18 14:05:09", "14:05:09"},
+		{datetime.PrecisionMillisecond, "2026-10-18 14:05:09.123", "14:05:09.120"},
+		{datetime.PrecisionMicrosecond, "2026-10-18 14:05:09.123456", "14:05:09.120000"},
+		{datetime.PrecisionNanosecond, "2026-10-18 14:05:09.123456789", "14:05:09.120000000"},
+		{2, "2026-10-18 14:05:09.12", "14:05:09.12"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.datetime, func(t *testing.T) {
+			assert.Equal(t, tt.datetime, d.ToStringPrecision(tt.precision))
+			assert.Equal(t, tt.time, tm.(*time.Time).ToStringPrecision(tt.precision))
+		})
+	}
+
+	_, err := datetime.NewNano(2026, 10, 18, 14, 5, 9, 1000000000)
+	assert.ErrorIs(t, err, datetime.ErrOutOfRange)
+	_, err = time.NewNano(14, 5, 9, -1)
+	assert.ErrorIs(t, err, datetime.ErrOutOfRange)
+}
+
+func TestTruncateRound(t *testing.T) {
+	d, _ := datetime.NewNano(2026, 12, 31, 23, 59, 59, 987654321)
+	tm, _ := time.NewNano(23, 59, 59, 987654321)
+
+	tests := []struct {
+		name     string
+		unit     goTime.Duration
+		truncate string
+		round    string
+		time     string
+	}{
+		{"millisecond", goTime.Millisecond, "2026-12-31 23:59:59.987", "2026-12-31 23:59:59.988", "23:59:59.988"},
+		{"microsecond", goTime.Microsecond, "2026-12-31 23:59:59.987654", "2026-12-31 23:59:59.987654", "23:59:59.987654"},
+		{"second", goTime.Second, "2026-12-31 23:59:59", "2027-01-01 00:00:00", "00:00:00"},
+		{"minute", goTime.Minute, "2026-12-31 23:59:00", "2027-01-01 00:00:00", "00:00:00"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.truncate, d.Truncate(tt.unit).ToString())
+			assert.Equal(t, tt.round, d.Round(tt.unit).ToString())
+			assert.Equal(t, tt.truncate[11:], tm.(*time.Time).Truncate(tt.unit).ToString())
+			assert.Equal(t, tt.time, tm.(*time.Time).Round(tt.unit).ToString())
+		})
+	}
+
+	// 7 hours do not divide day, time is truncated from midnight of the same day
+	early, _ := time.NewNano(0, 30, 0, 0)
+	truncated := early.(*time.Time).Truncate(7 * goTime.Hour)
+	midnight, _ := time.New(0, 0, 0)
+
+	assert.Equal(t, "00:00:00", truncated.ToString())
+	assert.Equal(t, 0, truncated.Compare(midnight))
+	assert.True(t, truncated.Equal(midnight))
+
+	// date time is truncated and rounded the same way
+	day, _ := datetime.New(2026, 10, 18, 15, 10, 0)
+	assert.Equal(t, "2026-10-18 14:00:00", day.Truncate(7*goTime.Hour).ToString())
+	assert.Equal(t, "2026-10-18 14:00:00", day.Round(7*goTime.Hour).ToString())
+	assert.Equal(t, day.FloorToStep(7*goTime.Hour), day.Truncate(7*goTime.Hour))
+
+	afternoon, _ := time.New(15, 10, 0)
+	assert.Equal(t, "14:00:00", afternoon.(*time.Time).Truncate(7*goTime.Hour).ToString())
+	assert.Equal(t, "14:00:00", afternoon.(*time.Time).Round(7*goTime.Hour).ToString())
+
+	// 7 minutes do not divide day, time is rounded from midnight of the same day
+	afterMidnight, _ := time.New(0, 2, 0)
+	assert.Equal(t, "00:00:00", afterMidnight.(*time.Time).Round(7*goTime.Minute).ToString())
+}
+
+func TestSubSecondOrder(t *testing.T) {
+	first, _ := datetime.FromString("2026-10-18 14:05:09.000001")
+	second, _ := datetime.FromString("2026-10-18 14:05:09.000002")
+	whole, _ := datetime.FromString("2026-10-18 14:05:09")
+
+	assert.True(t, first.Before(second))
+	assert.True(t, whole.Before(first))
+	assert.Equal(t, 1, second.Compare(first))
+	assert.False(t, first.Equal(whole))
+
+	morning, _ := time.FromString("08:00:00.25")
+	later, _ := time.FromString("08:00:00.5")
+	assert.True(t, morning.Before(later))
+
+	window, err := datetime.RangeFromString("[2026-10-18 14:05:09.000001, 2026-10-18 14:05:09.000003]")
+	assert.NoError(t, err)
+	assert.True(t, window.Is("2026-10-18 14:05:09.000002"))
+	assert.False(t, window.Is("2026-10-18 14:05:09.000004"))
+
+	clock := datetime.NewFakeClock(goTime.Date(2026, 10, 18, 14, 5, 9, 1500, goTime.UTC))
+	datetime.SetClock(clock)
+	defer datetime.SetClock(nil)
+	assert.Equal(t, 1500, datetime.Now().Nanosecond())
+	assert.Equal(t, 1500, time.Now().Nanosecond())
+}
+
+func TestPatternFraction(t *testing.T) {
+	value := goTime.Date(2026, 10, 18, 14, 5, 9, 123456789, goTime.UTC)
+
+	tests := []struct {
+		pattern  datetime.Pattern
+		expected string
+		parsed   int
+	}{
+		{datetime.ICU("HH:mm:ss.SSS"), "14:05:09.123", 123000000},
+		{datetime.ICU("HH:mm:ss.S"), "14:05:09.1", 100000000},
+		{datetime.ICU("HH:mm:ss.SSSSSSSSS"), "14:05:09.123456789", 123456789},
+		{datetime.PHP("H:i:s.v"), "14:05:09.123", 123000000},
+		{datetime.PHP("H:i:s.u"), "14:05:09.123456", 123456000},
+		{datetime.Strftime("%H:%M:%S.%N"), "14:05:09.123456789", 123456789},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.pattern.Layout, func(t *testing.T) {
+			assert.Equal(t, tt.expected, datetime.FormatTime(value, tt.pattern))
+
+			parsed, err := datetime.ParseTime(tt.pattern, tt.expected)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.parsed, parsed.Nanosecond())
+		})
+	}
+
+	_, err := datetime.ParseTime(datetime.ICU("HH:mm:ss.SSS"), "14:05:09.12")
+	assert.ErrorIs(t, err, datetime.ErrInvalidFormat)
+}
+
+func TestMarshalFraction(t *testing.T) {
+	d, _ := datetime.NewNano(2026, 10, 18, 14, 5, 9, 123456789)
+	tm, _ := time.NewNano(14, 5, 9, 1000)
+
+	data, err := d.MarshalBinary()
+	assert.NoError(t, err)
+	assert.Len(t, data, 13)
+
+	var parsed datetime.DateTime
+	assert.NoError(t, parsed.UnmarshalBinary(data))
+	assert.True(t, parsed.Equal(d))
+
+	whole, _ := datetime.New(2026, 10, 18, 14, 5, 9)
+	data, _ = whole.MarshalBinary()
+	assert.Len(t, data, 9)
+
+	data, err = tm.(*time.Time).MarshalBinary()
+	assert.NoError(t, err)
+
+	var parsedTime time.Time
+	assert.NoError(t, parsedTime.UnmarshalBinary(data))
+	assert.Equal(t, 1000, parsedTime.Nanosecond())
+
+	encoded, err := json.Marshal(d)
+	assert.NoError(t, err)
+	assert.Equal(t, `"2026-10-18 14:05:09.123456789"`, string(encoded))
+	assert.NoError(t, json.Unmarshal(encoded, &parsed))
+	assert.Equal(t, 123456789, parsed.Nanosecond())
+}
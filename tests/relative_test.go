@@ -0,0 +1,169 @@
+package tests
+
+import (
+	"github.com/gouef/datetime"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+func TestParseRelative(t *testing.T) {
+	// Wednesday
+	reference, _ := datetime.New(2026, 10, 14, 10, 30, 0)
+
+	tests := []struct {
+		expression  string
+		expected    string
+		expectedErr bool
+	}{
+		{"now", "2026-10-14 10:30:00", false},
+		{"today", "2026-10-14 00:00:00", false},
+		{"Tomorrow 14:00", "2026-10-15 14:00:00", false},
+		{"yesterday at noon", "2026-10-13 12:00:00", false},
+		{"day after tomorrow 2:30 pm", "2026-10-16 14:30:00", false},
+		{"17:45", "2026-10-14 17:45:00", false},
+		{"next monday", "2026-10-19 00:00:00", false},
+		{"next wednesday", "2026-10-21 00:00:00", false},
+		{"last friday", "2026-10-09 00:00:00", false},
+		{"this sunday", "2026-10-18 00:00:00", false},
+		{"+3 days", "2026-10-17 10:30:00", false},
+		{"-2 hours", "2026-10-14 08:30:00", false},
+		{"in 2 weeks", "2026-10-28 10:30:00", false},
+		{"3 months ago", "2026-07-14 10:30:00", false},
+		{"a year from now", "2027-10-14 10:30:00", false},
+		{"next month", "2026-11-14 10:30:00", false},
+		{"last day of next month", "2026-11-30 00:00:00", false},
+		{"first day of this month", "2026-10-01 00:00:00", false},
+		{"first friday of march", "2026-03-06 00:00:00", false},
+		{"last monday of may 2027", "2027-05-31 00:00:00", false},
+		{"second tuesday of next month", "2026-11-10 00:00:00", false},
+		{"end of quarter", "2026-12-31 23:59:59.999999999", false},
+		{"start of next quarter", "2027-01-01 00:00:00", false},
+		{"beginning of week", "2026-10-12 00:00:00", false},
+		{"end of year", "2026-12-31 23:59:59.999999999", false},
+		{"last business day of month", "2026-10-30 00:00:00", false},
+		{"next business day", "2026-10-15 00:00:00", false},
+		{"+3 business days", "2026-10-19 10:30:00", false},
+		{"2026-12-24 18:00", "2026-12-24 18:00:00", false},
+		{"tomorrow +2 hours", "2026-10-15 02:00:00", false},
+		{"", "", true},
+		{"next blursday", "", true},
+		{"fifth monday of february", "", true},
+		{"tomorrow 25:00", "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run("ParseRelative: "+tt.expression, func(t *testing.T) {
+			d, err := datetime.ParseRelative(tt.expression, reference)
+
+			if tt.expectedErr {
+				assert.Error(t, err)
+				assert.Nil(t, d)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expected, d.ToString())
+			}
+		})
+	}
+
+	t.Run("end of period is EndOf", func(t *testing.T) {
+		for expression, expected := range map[string]datetime.DateTime{
+			"end of day":     reference.EndOfDay(),
+			"end of week":    reference.EndOfWeek(time.Monday),
+			"end of month":   reference.EndOfMonth(),
+			"end of quarter": reference.EndOfQuarter(),
+			"end of year":    reference.EndOfYear(),
+		} {
+			d, err := datetime.ParseRelative(expression, reference)
+			assert.NoError(t, err)
+			assert.True(t, expected.Equal(d), expression)
+		}
+	})
+
+	t.Run("ParseRelativeWithCalendar", func(t *testing.T) {
+		beforeChristmas, _ := datetime.New(2026, 12, 23, 9, 0, 0)
+		calendar := datetime.NewHolidayCalendar(
+			time.Date(2026, 12, 24, 0, 0, 0, 0, time.UTC),
+			time.Date(2026, 12, 25, 0, 0, 0, 0, time.UTC),
+		)
+
+		d, err := datetime.ParseRelativeWithCalendar("next business day", beforeChristmas, calendar)
+		assert.NoError(t, err)
+		assert.Equal(t, "2026-12-28 00:00:00", d.ToString())
+
+		d, err = datetime.ParseRelativeWithCalendar("2 business days ago", beforeChristmas, calendar)
+		assert.NoError(t, err)
+		assert.Equal(t, "2026-12-21 09:00:00", d.ToString())
+	})
+}
+
+func TestParseRelativeErrorPosition(t *testing.T) {
+	reference, _ := datetime.New(2026, 10, 14, 10, 30, 0)
+
+	tests := []struct {
+		expression string
+		position   int
+	}{
+		{"next blursday", 5},
+		// "Š" is encoded as 0xC5 0xA0, 0xA0 is not a space
+		{"next Šťastný", 5},
+		{"tomorrow\u00a0Šťastný", 10},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expression, func(t *testing.T) {
+			_, err := datetime.ParseRelative(tt.expression, reference)
+
+			var parseError *datetime.ParseError
+			require.ErrorAs(t, err, &parseError)
+			assert.Equal(t, tt.position, parseError.Position)
+		})
+	}
+}
+
+func TestHolidayCalendar(t *testing.T) {
+	calendar := datetime.NewHolidayCalendar(time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)).
+		AddRule(datetime.GetGoodFriday)
+
+	assert.True(t, calendar.IsHoliday(time.Date(2026, 1, 1, 15, 0, 0, 0, time.UTC)))
+	assert.True(t, calendar.IsHoliday(time.Date(2026, 4, 3, 0, 0, 0, 0, time.UTC)))
+	assert.False(t, calendar.IsBusinessDay(time.Date(2026, 4, 4, 0, 0, 0, 0, time.UTC)))
+	assert.True(t, calendar.IsBusinessDay(time.Date(2026, 4, 7, 0, 0, 0, 0, time.UTC)))
+
+	calendar.WithWeekend(time.Friday)
+	assert.True(t, calendar.IsBusinessDay(time.Date(2026, 4, 4, 0, 0, 0, 0, time.UTC)))
+
+	added, err := datetime.AddBusinessDays(time.Date(2026, 4, 2, 0, 0, 0, 0, time.UTC), 2, datetime.WeekendCalendar())
+	assert.NoError(t, err)
+	assert.Equal(t, time.Date(2026, 4, 6, 0, 0, 0, 0, time.UTC), added)
+}
+
+func TestNoBusinessDay(t *testing.T) {
+	reference, _ := datetime.New(2026, 10, 14, 10, 30, 0)
+	everyDay := datetime.NewHolidayCalendar().
+		WithWeekend(time.Sunday, time.Monday, time.Tuesday, time.Wednesday, time.Thursday, time.Friday, time.Saturday)
+
+	// holidays of more than a year around reference
+	holidays := datetime.NewHolidayCalendar().WithWeekend()
+
+	for day := time.Date(2025, 9, 1, 0, 0, 0, 0, time.UTC); day.Year() < 2028; day = day.AddDate(0, 0, 1) {
+		holidays.Add(day)
+	}
+
+	for name, calendar := range map[string]datetime.BusinessCalendar{"weekend": everyDay, "holidays": holidays} {
+		t.Run(name, func(t *testing.T) {
+			_, err := datetime.AddBusinessDays(reference.Time(), 1, calendar)
+			assert.ErrorIs(t, err, datetime.ErrNoBusinessDay)
+
+			_, err = datetime.AddBusinessDays(reference.Time(), -1, calendar)
+			assert.ErrorIs(t, err, datetime.ErrNoBusinessDay)
+
+			_, err = datetime.LastBusinessDayOfMonth(2026, 10, calendar)
+			assert.ErrorIs(t, err, datetime.ErrNoBusinessDay)
+
+			_, err = datetime.ParseRelativeWithCalendar("next business day", reference, calendar)
+			assert.ErrorIs(t, err, datetime.ErrNoBusinessDay)
+		})
+	}
+}
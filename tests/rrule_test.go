@@ -0,0 +1,476 @@
+package tests
+
+import (
+	"github.com/gouef/datetime"
+	"github.com/gouef/datetime/rrule"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"strings"
+	"testing"
+	"time"
+)
+
+const newYork = "DTSTART;TZID=America/New_York:"
+
+// occurrences returns at most limit occurrences of set as wall clock "19970902T090000"
+func occurrences(t *testing.T, value string, limit int) []string {
+	set, err := rrule.ParseSet(value)
+	require.NoError(t, err)
+
+	var result []string
+
+	for occurrence := range set.Times() {
+		if len(result) == limit {
+			break
+		}
+
+		result = append(result, occurrence.Format("20060102T150405"))
+	}
+
+	return result
+}
+
+// days returns prefix + day + "T" + clock for each day
+func days(prefix, clock string, values ...string) []string {
+	result := make([]string, len(values))
+
+	for i, value := range values {
+		result[i] = prefix + value + "T" + clock
+	}
+
+	return result
+}
+
+// clocks returns day + "T" + clock for each clock
+func clocks(day string, values ...string) []string {
+	result := make([]string, len(values))
+
+	for i, value := range values {
+		result[i] = day + "T" + value
+	}
+
+	return result
+}
+
+func TestRuleRFC5545Examples(t *testing.T) {
+	tests := []struct {
+		name     string
+		set      string
+		limit    int
+		expected []string
+	}{
+		{
+			"daily for 10 occurrences",
+			newYork + "19970902T090000\nRRULE:FREQ=DAILY;COUNT=10", 100,
+			days("199709", "090000", "02", "03", "04", "05", "06", "07", "08", "09", "10", "11"),
+		},
+		{
+			"every other day",
+			newYork + "19970902T090000\nRRULE:FREQ=DAILY;INTERVAL=2", 5,
+			days("199709", "090000", "02", "04", "06", "08", "10"),
+		},
+		{
+			"every 10 days, 5 occurrences",
+			newYork + "19970902T090000\nRRULE:FREQ=DAILY;INTERVAL=10;COUNT=5", 100,
+			[]string{"19970902T090000", "19970912T090000", "19970922T090000", "19971002T090000", "19971012T090000"},
+		},
+		{
+			"weekly for 10 occurrences",
+			newYork + "19970902T090000\nRRULE:FREQ=WEEKLY;COUNT=10", 100,
+			[]string{"19970902T090000", "19970909T090000", "19970916T090000", "19970923T090000", "19970930T090000",
+				"19971007T090000", "19971014T090000", "19971021T090000", "19971028T090000", "19971104T090000"},
+		},
+		{
+			"every other week",
+			newYork + "19970902T090000\nRRULE:FREQ=WEEKLY;INTERVAL=2;WKST=SU", 13,
+			[]string{"19970902T090000", "19970916T090000", "19970930T090000", "19971014T090000", "19971028T090000",
+				"19971111T090000", "19971125T090000", "19971209T090000", "19971223T090000", "19980106T090000",
+				"19980120T090000", "19980203T090000", "19980217T090000"},
+		},
+		{
+			"weekly on tuesday and thursday for five weeks",
+			newYork + "19970902T090000\nRRULE:FREQ=WEEKLY;UNTIL=19971007T000000Z;WKST=SU;BYDAY=TU,TH", 100,
+			days("1997", "090000", "0902", "0904", "0909", "0911", "0916", "0918", "0923", "0925", "0930", "1002"),
+		},
+		{
+			"every other week on monday, wednesday and friday",
+			newYork + "19970901T090000\nRRULE:FREQ=WEEKLY;INTERVAL=2;UNTIL=19971224T000000Z;WKST=SU;BYDAY=MO,WE,FR", 100,
+			days("1997", "090000", "0901", "0903", "0905", "0915", "0917", "0919", "0929", "1001", "1003", "1013", "1015",
+				"1017", "1027", "1029", "1031", "1110", "1112", "1114", "1124", "1126", "1128", "1208", "1210", "1212", "1222"),
+		},
+		{
+			"every other week on tuesday and thursday for 8 occurrences",
+			newYork + "19970902T090000\nRRULE:FREQ=WEEKLY;INTERVAL=2;COUNT=8;WKST=SU;BYDAY=TU,TH", 100,
+			days("1997", "090000", "0902", "0904", "0916", "0918", "0930", "1002", "1014", "1016"),
+		},
+		{
+			"monthly on the first friday for 10 occurrences",
+			newYork + "19970905T090000\nRRULE:FREQ=MONTHLY;COUNT=10;BYDAY=1FR", 100,
+			[]string{"19970905T090000", "19971003T090000", "19971107T090000", "19971205T090000", "19980102T090000",
+				"19980206T090000", "19980306T090000", "19980403T090000", "19980501T090000", "19980605T090000"},
+		},
+		{
+			"monthly on the first friday until december 24",
+			newYork + "19970905T090000\nRRULE:FREQ=MONTHLY;UNTIL=19971224T000000Z;BYDAY=1FR", 100,
+			days("1997", "090000", "0905", "1003", "1107", "1205"),
+		},
+		{
+			"every other month on the first and last sunday",
+			newYork + "19970907T090000\nRRULE:FREQ=MONTHLY;INTERVAL=2;COUNT=10;BYDAY=1SU,-1SU", 100,
+			[]string{"19970907T090000", "19970928T090000", "19971102T090000", "19971130T090000", "19980104T090000",
+				"19980125T090000", "19980301T090000", "19980329T090000", "19980503T090000", "19980531T090000"},
+		},
+		{
+			"monthly on the second-to-last monday for 6 months",
+			newYork + "19970922T090000\nRRULE:FREQ=MONTHLY;COUNT=6;BYDAY=-2MO", 100,
+			[]string{"19970922T090000", "19971020T090000", "19971117T090000", "19971222T090000", "19980119T090000", "19980216T090000"},
+		},
+		{
+			"monthly on the third-to-last day",
+			newYork + "19970928T090000\nRRULE:FREQ=MONTHLY;BYMONTHDAY=-3", 6,
+			[]string{"19970928T090000", "19971029T090000", "19971128T090000", "19971229T090000", "19980129T090000", "19980226T090000"},
+		},
+		{
+			"monthly on the 2nd and 15th for 10 occurrences",
+			newYork + "19970902T090000\nRRULE:FREQ=MONTHLY;COUNT=10;BYMONTHDAY=2,15", 100,
+			[]string{"19970902T090000", "19970915T090000", "19971002T090000", "19971015T090000", "19971102T090000",
+				"19971115T090000", "19971202T090000", "19971215T090000", "19980102T090000", "19980115T090000"},
+		},
+		{
+			"monthly on the first and last day for 10 occurrences",
+			newYork + "19970930T090000\nRRULE:FREQ=MONTHLY;COUNT=10;BYMONTHDAY=1,-1", 100,
+			[]string{"19970930T090000", "19971001T090000", "19971031T090000", "19971101T090000", "19971130T090000",
+				"19971201T090000", "19971231T090000", "19980101T090000", "19980131T090000", "19980201T090000"},
+		},
+		{
+			"every 18 months on the 10th thru 15th for 10 occurrences",
+			newYork + "19970910T090000\nRRULE:FREQ=MONTHLY;INTERVAL=18;COUNT=10;BYMONTHDAY=10,11,12,13,14,15", 100,
+			append(days("199709", "090000", "10", "11", "12", "13", "14", "15"), days("199903", "090000", "10", "11", "12", "13")...),
+		},
+		{
+			"every tuesday, every other month",
+			newYork + "19970902T090000\nRRULE:FREQ=MONTHLY;INTERVAL=2;BYDAY=TU", 18,
+			append(append(days("1997", "090000", "0902", "0909", "0916", "0923", "0930", "1104", "1111", "1118", "1125"),
+				days("1998", "090000", "0106", "0113", "0120", "0127", "0303", "0310", "0317", "0324")...), "19980331T090000"),
+		},
+		{
+			"yearly in june and july for 10 occurrences",
+			newYork + "19970610T090000\nRRULE:FREQ=YEARLY;COUNT=10;BYMONTH=6,7", 100,
+			[]string{"19970610T090000", "19970710T090000", "19980610T090000", "19980710T090000", "19990610T090000",
+				"19990710T090000", "20000610T090000", "20000710T090000", "20010610T090000", "20010710T090000"},
+		},
+		{
+			"every other year on january, february and march for 10 occurrences",
+			newYork + "19970310T090000\nRRULE:FREQ=YEARLY;INTERVAL=2;COUNT=10;BYMONTH=1,2,3", 100,
+			[]string{"19970310T090000", "19990110T090000", "19990210T090000", "19990310T090000", "20010110T090000",
+				"20010210T090000", "20010310T090000", "20030110T090000", "20030210T090000", "20030310T090000"},
+		},
+		{
+			"every third year on the 1st, 100th and 200th day for 10 occurrences",
+			newYork + "19970101T090000\nRRULE:FREQ=YEARLY;INTERVAL=3;COUNT=10;BYYEARDAY=1,100,200", 100,
+			[]string{"19970101T090000", "19970410T090000", "19970719T090000", "20000101T090000", "20000409T090000",
+				"20000718T090000", "20030101T090000", "20030410T090000", "20030719T090000", "20060101T090000"},
+		},
+		{
+			"every 20th monday of the year",
+			newYork + "19970519T090000\nRRULE:FREQ=YEARLY;BYDAY=20MO", 3,
+			[]string{"19970519T090000", "19980518T090000", "19990517T090000"},
+		},
+		{
+			"monday of week number 20",
+			newYork + "19970512T090000\nRRULE:FREQ=YEARLY;BYWEEKNO=20;BYDAY=MO", 3,
+			[]string{"19970512T090000", "19980511T090000", "19990517T090000"},
+		},
+		{
+			"every thursday in march",
+			newYork + "19970313T090000\nRRULE:FREQ=YEARLY;BYMONTH=3;BYDAY=TH", 11,
+			append(append(days("199703", "090000", "13", "20", "27"), days("199803", "090000", "05", "12", "19", "26")...),
+				days("199903", "090000", "04", "11", "18", "25")...),
+		},
+		{
+			"every thursday only during june, july and august",
+			newYork + "19970605T090000\nRRULE:FREQ=YEARLY;BYDAY=TH;BYMONTH=6,7,8", 13,
+			days("1997", "090000", "0605", "0612", "0619", "0626", "0703", "0710", "0717", "0724", "0731", "0807", "0814", "0821", "0828"),
+		},
+		{
+			"every friday the 13th",
+			newYork + "19970902T090000\nEXDATE;TZID=America/New_York:19970902T090000\nRRULE:FREQ=MONTHLY;BYDAY=FR;BYMONTHDAY=13", 5,
+			[]string{"19980213T090000", "19980313T090000", "19981113T090000", "19990813T090000", "20001013T090000"},
+		},
+		{
+			"the first saturday that follows the first sunday of the month",
+			newYork + "19970913T090000\nRRULE:FREQ=MONTHLY;BYDAY=SA;BYMONTHDAY=7,8,9,10,11,12,13", 10,
+			[]string{"19970913T090000", "19971011T090000", "19971108T090000", "19971213T090000", "19980110T090000",
+				"19980207T090000", "19980307T090000", "19980411T090000", "19980509T090000", "19980613T090000"},
+		},
+		{
+			"us presidential election day",
+			newYork + "19961105T090000\nRRULE:FREQ=YEARLY;INTERVAL=4;BYMONTH=11;BYDAY=TU;BYMONTHDAY=2,3,4,5,6,7,8", 3,
+			[]string{"19961105T090000", "20001107T090000", "20041102T090000"},
+		},
+		{
+			"the third instance of tuesday, wednesday or thursday for the next 3 months",
+			newYork + "19970904T090000\nRRULE:FREQ=MONTHLY;COUNT=3;BYDAY=TU,WE,TH;BYSETPOS=3", 100,
+			[]string{"19970904T090000", "19971007T090000", "19971106T090000"},
+		},
+		{
+			"the second-to-last weekday of the month",
+			newYork + "19970929T090000\nRRULE:FREQ=MONTHLY;BYDAY=MO,TU,WE,TH,FR;BYSETPOS=-2", 7,
+			[]string{"19970929T090000", "19971030T090000", "19971127T090000", "19971230T090000", "19980129T090000",
+				"19980226T090000", "19980330T090000"},
+		},
+		{
+			"every 15 minutes for 6 occurrences",
+			newYork + "19970902T090000\nRRULE:FREQ=MINUTELY;INTERVAL=15;COUNT=6", 100,
+			clocks("19970902", "090000", "091500", "093000", "094500", "100000", "101500"),
+		},
+		{
+			"every hour and a half for 4 occurrences",
+			newYork + "19970902T090000\nRRULE:FREQ=MINUTELY;INTERVAL=90;COUNT=4", 100,
+			clocks("19970902", "090000", "103000", "120000", "133000"),
+		},
+		{
+			"every 20 minutes from 9:00 to 16:40, daily",
+			newYork + "19970902T090000\nRRULE:FREQ=DAILY;BYHOUR=9,10,11,12,13,14,15,16;BYMINUTE=0,20,40", 26,
+			append(clocks("19970902", "090000", "092000", "094000", "100000", "102000", "104000", "110000", "112000",
+				"114000", "120000", "122000", "124000", "130000", "132000", "134000", "140000", "142000", "144000", "150000",
+				"152000", "154000", "160000", "162000", "164000"), "19970903T090000", "19970903T092000"),
+		},
+		{
+			"every 20 minutes from 9:00 to 16:40, minutely",
+			newYork + "19970902T090000\nRRULE:FREQ=MINUTELY;INTERVAL=20;BYHOUR=9,10,11,12,13,14,15,16", 26,
+			append(clocks("19970902", "090000", "092000", "094000", "100000", "102000", "104000", "110000", "112000",
+				"114000", "120000", "122000", "124000", "130000", "132000", "134000", "140000", "142000", "144000", "150000",
+				"152000", "154000", "160000", "162000", "164000"), "19970903T090000", "19970903T092000"),
+		},
+		{
+			"week start monday",
+			newYork + "19970805T090000\nRRULE:FREQ=WEEKLY;INTERVAL=2;COUNT=4;BYDAY=TU,SU;WKST=MO", 100,
+			days("1997", "090000", "0805", "0810", "0819", "0824"),
+		},
+		{
+			"week start sunday",
+			newYork + "19970805T090000\nRRULE:FREQ=WEEKLY;INTERVAL=2;COUNT=4;BYDAY=TU,SU;WKST=SU", 100,
+			days("1997", "090000", "0805", "0817", "0819", "0831"),
+		},
+		{
+			"invalid dates are ignored",
+			newYork + "20070115T090000\nRRULE:FREQ=MONTHLY;BYMONTHDAY=15,30;COUNT=5", 100,
+			[]string{"20070115T090000", "20070130T090000", "20070215T090000", "20070315T090000", "20070330T090000"},
+		},
+		{
+			"rdate and exdate",
+			"DTSTART:20260105T100000\nRRULE:FREQ=WEEKLY;COUNT=3\nRDATE:20260107T100000,20260112T100000\nEXDATE:20260112T100000", 100,
+			[]string{"20260105T100000", "20260107T100000", "20260119T100000"},
+		},
+		{
+			"all day",
+			"DTSTART;VALUE=DATE:20260227\nRRULE:FREQ=DAILY;UNTIL=20260302", 100,
+			[]string{"20260227T000000", "20260228T000000", "20260301T000000", "20260302T000000"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, occurrences(t, tt.set, tt.limit))
+		})
+	}
+}
+
+func TestRuleRFC5545Counts(t *testing.T) {
+	tests := []struct {
+		name  string
+		set   string
+		count int
+		last  string
+	}{
+		{"daily until december 24", newYork + "19970902T090000\nRRULE:FREQ=DAILY;UNTIL=19971224T000000Z", 113, "19971223T090000"},
+		{"weekly until december 24", newYork + "19970902T090000\nRRULE:FREQ=WEEKLY;UNTIL=19971224T000000Z", 17, "19971223T090000"},
+		{
+			"every day in january for 3 years, yearly",
+			newYork + "19980101T090000\nRRULE:FREQ=YEARLY;UNTIL=20000131T140000Z;BYMONTH=1;BYDAY=SU,MO,TU,WE,TH,FR,SA", 93, "20000131T090000",
+		},
+		{"every day in january for 3 years, daily", newYork + "19980101T090000\nRRULE:FREQ=DAILY;UNTIL=20000131T140000Z;BYMONTH=1", 93, "20000131T090000"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := occurrences(t, tt.set, 1000)
+
+			assert.Len(t, result, tt.count)
+			assert.Equal(t, tt.last, result[len(result)-1])
+		})
+	}
+}
+
+func TestRuleTimeZone(t *testing.T) {
+	set, err := rrule.ParseSet(newYork + "19971021T090000\nRRULE:FREQ=WEEKLY;COUNT=3")
+	require.NoError(t, err)
+
+	var result []string
+
+	for occurrence := range set.Times() {
+		result = append(result, occurrence.UTC().Format(time.RFC3339))
+	}
+
+	// wall clock stays at 9:00 when daylight saving time ends on October 26, 1997
+	assert.Equal(t, []string{"1997-10-21T13:00:00Z", "1997-10-28T14:00:00Z", "1997-11-04T14:00:00Z"}, result)
+}
+
+func TestRuleDaylightSavingTime(t *testing.T) {
+	tests := []struct {
+		name     string
+		set      string
+		expected []string
+	}{
+		// 02:30 on March 8 does not exist, it is interpreted with offset before the gap
+		{"spring forward", newYork + "20260307T023000\nRRULE:FREQ=DAILY;COUNT=3", []string{
+			"2026-03-07T02:30:00-05:00", "2026-03-08T03:30:00-04:00", "2026-03-09T02:30:00-04:00",
+		}},
+		{"spring forward eastward", "DTSTART;TZID=Europe/Prague:20260328T023000\nRRULE:FREQ=DAILY;COUNT=3", []string{
+			"2026-03-28T02:30:00+01:00", "2026-03-29T03:30:00+02:00", "2026-03-30T02:30:00+02:00",
+		}},
+		// 01:30 on November 1 is repeated, the first occurrence is used
+		{"fall back", newYork + "20261031T013000\nRRULE:FREQ=DAILY;COUNT=3", []string{
+			"2026-10-31T01:30:00-04:00", "2026-11-01T01:30:00-04:00", "2026-11-02T01:30:00-05:00",
+		}},
+		{"fall back eastward", "DTSTART;TZID=Europe/Prague:20261024T023000\nRRULE:FREQ=DAILY;COUNT=3", []string{
+			"2026-10-24T02:30:00+02:00", "2026-10-25T02:30:00+02:00", "2026-10-26T02:30:00+01:00",
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			set, err := rrule.ParseSet(tt.set)
+			require.NoError(t, err)
+
+			var result []string
+
+			for occurrence := range set.Times() {
+				result = append(result, occurrence.Format(time.RFC3339))
+			}
+
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
+
+func TestSetBetween(t *testing.T) {
+	set, err := rrule.ParseSet("DTSTART:20260101T090000\nRRULE:FREQ=WEEKLY;BYDAY=MO")
+	require.NoError(t, err)
+
+	r, err := datetime.NewRangeStrict("2026-02-01 00:00:00", "2026-03-01 00:00:00")
+	require.NoError(t, err)
+
+	var result []string
+
+	for d := range set.Between(r) {
+		result = append(result, d.ToString())
+	}
+
+	assert.Equal(t, []string{"2026-02-02 09:00:00", "2026-02-09 09:00:00", "2026-02-16 09:00:00", "2026-02-23 09:00:00"}, result)
+
+	var first []string
+
+	for d := range set.All() {
+		first = append(first, d.ToString())
+
+		if len(first) == 2 {
+			break
+		}
+	}
+
+	assert.Equal(t, []string{"2026-01-01 09:00:00", "2026-01-05 09:00:00"}, first)
+}
+
+func TestSetAllBeforeYearZero(t *testing.T) {
+	rule, err := rrule.Parse("FREQ=DAILY;COUNT=3")
+	require.NoError(t, err)
+
+	set := &rrule.Set{Start: time.Date(-1, 12, 31, 9, 0, 0, 0, time.UTC), Rules: []*rrule.Rule{rule}}
+
+	var result []string
+
+	for d := range set.All() {
+		result = append(result, d.ToString())
+	}
+
+	assert.Equal(t, []string{"0000-01-01 09:00:00", "0000-01-02 09:00:00"}, result)
+}
+
+func TestRuleString(t *testing.T) {
+	tests := []struct {
+		value    string
+		expected string
+	}{
+		{"FREQ=DAILY;COUNT=10", "FREQ=DAILY;COUNT=10"},
+		{"RRULE:freq=monthly;byday=+1fr,-1SU;interval=2", "FREQ=MONTHLY;INTERVAL=2;BYDAY=1FR,-1SU"},
+		{"FREQ=WEEKLY;UNTIL=19971224T000000Z;WKST=SU;BYDAY=TU,TH", "FREQ=WEEKLY;UNTIL=19971224T000000Z;BYDAY=TU,TH;WKST=SU"},
+		{"FREQ=YEARLY;BYMONTH=1;UNTIL=20000131", "FREQ=YEARLY;UNTIL=20000131;BYMONTH=1"},
+		{"FREQ=MONTHLY;BYDAY=MO,TU,WE,TH,FR;BYSETPOS=-2", "FREQ=MONTHLY;BYDAY=MO,TU,WE,TH,FR;BYSETPOS=-2"},
+		{"FREQ=YEARLY;BYWEEKNO=20;BYYEARDAY=-1;BYMONTHDAY=-3", "FREQ=YEARLY;BYMONTHDAY=-3;BYYEARDAY=-1;BYWEEKNO=20"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			rule, err := rrule.Parse(tt.value)
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, rule.String())
+		})
+	}
+}
+
+func TestRuleParseErrors(t *testing.T) {
+	tests := []struct {
+		value string
+		err   error
+	}{
+		{"COUNT=10", datetime.ErrInvalidFormat},
+		{"FREQ=FORTNIGHTLY", datetime.ErrInvalidFormat},
+		{"FREQ=DAILY;COUNT=10;UNTIL=19971224T000000Z", datetime.ErrInvalidFormat},
+		{"FREQ=DAILY;COUNT=1;COUNT=2", datetime.ErrInvalidFormat},
+		{"FREQ=DAILY;BYHOUR=24", datetime.ErrOutOfRange},
+		{"FREQ=MONTHLY;BYMONTHDAY=0", datetime.ErrOutOfRange},
+		{"FREQ=MONTHLY;BYDAY=XX", datetime.ErrInvalidFormat},
+		{"FREQ=DAILY;INTERVAL=0", datetime.ErrOutOfRange},
+		{"FREQ=DAILY;UNTIL=1997", datetime.ErrInvalidFormat},
+		{"FREQ=DAILY;FOO=1", datetime.ErrInvalidFormat},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			_, err := rrule.Parse(tt.value)
+
+			assert.ErrorIs(t, err, tt.err)
+		})
+	}
+}
+
+func TestParseSetErrors(t *testing.T) {
+	tests := []struct {
+		name  string
+		value string
+	}{
+		{"without start", "RRULE:FREQ=DAILY"},
+		{"unknown property", "DTSTART:20260101T090000\nSUMMARY:Meeting"},
+		{"unknown time zone", "DTSTART;TZID=Mars/Olympus:20260101T090000"},
+		{"period", "DTSTART:20260101T090000\nRDATE;VALUE=PERIOD:20260101T090000/PT1H"},
+		{"two starts", "DTSTART:20260101T090000\nDTSTART:20260102T090000"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := rrule.ParseSet(tt.value)
+
+			assert.ErrorIs(t, err, datetime.ErrInvalidFormat)
+		})
+	}
+}
+
+func TestParseSetFolding(t *testing.T) {
+	set, err := rrule.ParseSet(strings.Join([]string{"DTSTART:20260101T090000", "RRULE:FREQ=DAILY;", " COUNT=2"}, "\r\n"))
+
+	assert.NoError(t, err)
+	assert.Equal(t, 2, set.Rules[0].Count)
+}
@@ -0,0 +1,200 @@
+package tests
+
+import (
+	"github.com/gouef/datetime"
+	"github.com/gouef/datetime/date"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestDateAdjust(t *testing.T) {
+	holidays := datetime.NewHolidayCalendar(datetime.GetDate(2026, 11, 2))
+
+	tests := []struct {
+		name     string
+		date     *date.Date
+		roll     date.Roll
+		calendar datetime.BusinessCalendar
+		expected string
+	}{
+		{"business day", newTestDate(2026, 10, 19), date.RollFollowing, nil, "2026-10-19"},
+		{"none", newTestDate(2026, 10, 31), date.RollNone, nil, "2026-10-31"},
+		{"following", newTestDate(2026, 10, 31), date.RollFollowing, nil, "2026-11-02"},
+		{"following holiday", newTestDate(2026, 10, 31), date.RollFollowing, holidays, "2026-11-03"},
+		{"modified following", newTestDate(2026, 10, 31), date.RollModifiedFollowing, nil, "2026-10-30"},
+		{"modified following same month", newTestDate(2026, 10, 24), date.RollModifiedFollowing, nil, "2026-10-26"},
+		{"preceding", newTestDate(2026, 11, 1), date.RollPreceding, nil, "2026-10-30"},
+		{"modified preceding", newTestDate(2026, 11, 1), date.RollModifiedPreceding, nil, "2026-11-02"},
+		{"modified preceding holiday", newTestDate(2026, 11, 1), date.RollModifiedPreceding, holidays, "2026-11-03"},
+		{"modified preceding same month", newTestDate(2026, 10, 25), date.RollModifiedPreceding, nil, "2026-10-23"},
+		{"end of month", newTestDate(2026, 10, 5), date.RollEndOfMonth, nil, "2026-10-30"},
+		{"end of month business day", newTestDate(2026, 9, 1), date.RollEndOfMonth, nil, "2026-09-30"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d, err := tt.date.Adjust(tt.roll, tt.calendar)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, d.ToString())
+		})
+	}
+
+	everyDay := datetime.NewHolidayCalendar().
+		WithWeekend(time.Sunday, time.Monday, time.Tuesday, time.Wednesday, time.Thursday, time.Friday, time.Saturday)
+
+	for _, roll := range []date.Roll{date.RollFollowing, date.RollModifiedFollowing, date.RollPreceding, date.RollModifiedPreceding, date.RollEndOfMonth} {
+		t.Run("no business day "+roll.String(), func(t *testing.T) {
+			_, err := newTestDate(2026, 10, 19).Adjust(roll, everyDay)
+			assert.ErrorIs(t, err, datetime.ErrNoBusinessDay)
+		})
+	}
+}
+
+func TestDateSettlement(t *testing.T) {
+	holidays := datetime.NewHolidayCalendar(datetime.GetDate(2026, 10, 28))
+
+	tests := []struct {
+		name     string
+		trade    *date.Date
+		days     int
+		calendar datetime.BusinessCalendar
+		expected string
+	}{
+		{"T+2", newTestDate(2026, 10, 19), 2, nil, "2026-10-21"},
+		{"T+2 over weekend", newTestDate(2026, 10, 23), 2, nil, "2026-10-27"},
+		{"T+2 over holiday", newTestDate(2026, 10, 26), 2, holidays, "2026-10-29"},
+		{"T+1 traded on saturday", newTestDate(2026, 10, 24), 1, nil, "2026-10-27"},
+		{"T+0 traded on saturday", newTestDate(2026, 10, 24), 0, nil, "2026-10-26"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d, err := tt.trade.Settlement(tt.days, tt.calendar)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, d.ToString())
+		})
+	}
+}
+
+func TestScheduleDates(t *testing.T) {
+	tests := []struct {
+		name       string
+		schedule   date.Schedule
+		unadjusted []string
+		adjusted   []string
+	}{
+		{
+			name:       "regular quarterly",
+			schedule:   date.Schedule{Start: *newTestDate(2026, 1, 15), End: *newTestDate(2027, 1, 15), Months: 3},
+			unadjusted: []string{"2026-01-15", "2026-04-15", "2026-07-15", "2026-10-15", "2027-01-15"},
+		},
+		{
+			name:       "regular long initial",
+			schedule:   date.Schedule{Start: *newTestDate(2026, 1, 15), End: *newTestDate(2027, 1, 15), Months: 3, Stub: date.StubLongInitial},
+			unadjusted: []string{"2026-01-15", "2026-04-15", "2026-07-15", "2026-10-15", "2027-01-15"},
+		},
+		{
+			name:       "short initial",
+			schedule:   date.Schedule{Start: *newTestDate(2026, 2, 10), End: *newTestDate(2027, 1, 15), Months: 3},
+			unadjusted: []string{"2026-02-10", "2026-04-15", "2026-07-15", "2026-10-15", "2027-01-15"},
+		},
+		{
+			name:       "long initial",
+			schedule:   date.Schedule{Start: *newTestDate(2026, 2, 10), End: *newTestDate(2027, 1, 15), Months: 3, Stub: date.StubLongInitial},
+			unadjusted: []string{"2026-02-10", "2026-07-15", "2026-10-15", "2027-01-15"},
+		},
+		{
+			name:       "short final",
+			schedule:   date.Schedule{Start: *newTestDate(2026, 1, 15), End: *newTestDate(2026, 11, 20), Months: 3, Stub: date.StubShortFinal},
+			unadjusted: []string{"2026-01-15", "2026-04-15", "2026-07-15", "2026-10-15", "2026-11-20"},
+		},
+		{
+			name:       "long final",
+			schedule:   date.Schedule{Start: *newTestDate(2026, 1, 15), End: *newTestDate(2026, 11, 20), Months: 3, Stub: date.StubLongFinal},
+			unadjusted: []string{"2026-01-15", "2026-04-15", "2026-07-15", "2026-11-20"},
+		},
+		{
+			name:       "single stub",
+			schedule:   date.Schedule{Start: *newTestDate(2026, 1, 15), End: *newTestDate(2026, 2, 10), Months: 3, Stub: date.StubLongFinal},
+			unadjusted: []string{"2026-01-15", "2026-02-10"},
+		},
+		{
+			name:       "anchor",
+			schedule:   date.Schedule{Start: *newTestDate(2026, 1, 1), End: *newTestDate(2026, 12, 31), Months: 6, Anchor: *newTestDate(2026, 3, 20)},
+			unadjusted: []string{"2026-01-01", "2026-03-20", "2026-09-20", "2026-12-31"},
+		},
+		{
+			name:       "february anchor",
+			schedule:   date.Schedule{Start: *newTestDate(2026, 2, 28), End: *newTestDate(2026, 6, 30), Months: 1, Stub: date.StubShortFinal},
+			unadjusted: []string{"2026-02-28", "2026-03-28", "2026-04-28", "2026-05-28", "2026-06-28", "2026-06-30"},
+		},
+		{
+			name:       "end of month",
+			schedule:   date.Schedule{Start: *newTestDate(2026, 2, 28), End: *newTestDate(2026, 6, 30), Months: 1, Stub: date.StubShortFinal, EndOfMonth: true},
+			unadjusted: []string{"2026-02-28", "2026-03-31", "2026-04-30", "2026-05-31", "2026-06-30"},
+		},
+		{
+			name: "modified following",
+			schedule: date.Schedule{
+				Start:      *newTestDate(2026, 1, 31),
+				End:        *newTestDate(2026, 5, 31),
+				Months:     1,
+				Stub:       date.StubShortFinal,
+				EndOfMonth: true,
+				Roll:       date.RollModifiedFollowing,
+			},
+			unadjusted: []string{"2026-01-31", "2026-02-28", "2026-03-31", "2026-04-30", "2026-05-31"},
+			adjusted:   []string{"2026-01-30", "2026-02-27", "2026-03-31", "2026-04-30", "2026-05-29"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dates, err := tt.schedule.Dates()
+
+			assert.NoError(t, err)
+
+			adjusted := tt.adjusted
+
+			if adjusted == nil {
+				adjusted = tt.unadjusted
+			}
+
+			var gotUnadjusted, gotAdjusted []string
+
+			for _, d := range dates {
+				gotUnadjusted = append(gotUnadjusted, d.Unadjusted.ToString())
+				gotAdjusted = append(gotAdjusted, d.Adjusted.ToString())
+			}
+
+			assert.Equal(t, tt.unadjusted, gotUnadjusted)
+			assert.Equal(t, adjusted, gotAdjusted)
+		})
+	}
+}
+
+func TestScheduleDatesErrors(t *testing.T) {
+	tests := []struct {
+		name     string
+		schedule date.Schedule
+		err      error
+	}{
+		{"months", date.Schedule{Start: *newTestDate(2026, 1, 1), End: *newTestDate(2027, 1, 1)}, datetime.ErrOutOfRange},
+		{"end before start", date.Schedule{Start: *newTestDate(2027, 1, 1), End: *newTestDate(2026, 1, 1), Months: 1}, date.ErrInvalidSchedule},
+		{"empty", date.Schedule{Start: *newTestDate(2026, 1, 1), End: *newTestDate(2026, 1, 1), Months: 1}, date.ErrInvalidSchedule},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := tt.schedule.Dates()
+
+			assert.ErrorIs(t, err, tt.err)
+		})
+	}
+}
+
+func TestRollString(t *testing.T) {
+	assert.Equal(t, "Modified Following", date.RollModifiedFollowing.String())
+	assert.Equal(t, "Roll(42)", date.Roll(42).String())
+}
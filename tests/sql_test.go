@@ -0,0 +1,264 @@
+package tests
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"github.com/gouef/datetime"
+	"github.com/gouef/datetime/date"
+	"github.com/gouef/datetime/time"
+	"github.com/stretchr/testify/assert"
+	"io"
+	"sync"
+	"testing"
+	goTime "time"
+)
+
+// fakeDriver stores arguments of the last Exec and returns them as the only row of Query
+type fakeDriver struct {
+	mu   sync.Mutex
+	rows map[string][]driver.Value
+}
+
+type fakeConn struct{ driver *fakeDriver }
+
+type fakeStmt struct {
+	conn  *fakeConn
+	query string
+}
+
+type fakeRows struct {
+	values []driver.Value
+	done   bool
+}
+
+var fake = &fakeDriver{rows: map[string][]driver.Value{}}
+
+func init() {
+	sql.Register("fake", fake)
+}
+
+func (d *fakeDriver) Open(string) (driver.Conn, error) { return &fakeConn{d}, nil }
+
+func (c *fakeConn) Prepare(query string) (driver.Stmt, error) {
+	return &fakeStmt{c, query}, nil
+}
+func (c *fakeConn) Close() error              { return nil }
+func (c *fakeConn) Begin() (driver.Tx, error) { return nil, errors.New("not supported") }
+
+func (s *fakeStmt) Close() error  { return nil }
+func (s *fakeStmt) NumInput() int { return -1 }
+
+func (s *fakeStmt) Exec(args []driver.Value) (driver.Result, error) {
+	s.conn.driver.mu.Lock()
+	defer s.conn.driver.mu.Unlock()
+
+	s.conn.driver.rows[s.query] = args
+
+	return driver.RowsAffected(1), nil
+}
+
+func (s *fakeStmt) Query([]driver.Value) (driver.Rows, error) {
+	s.conn.driver.mu.Lock()
+	defer s.conn.driver.mu.Unlock()
+
+	return &fakeRows{values: s.conn.driver.rows[s.query]}, nil
+}
+
+func (r *fakeRows) Columns() []string { return make([]string, len(r.values)) }
+func (r *fakeRows) Close() error      { return nil }
+
+func (r *fakeRows) Next(dest []driver.Value) error {
+	if r.done {
+		return io.EOF
+	}
+
+	r.done = true
+	copy(dest, r.values)
+
+	return nil
+}
+
+func openFake(t *testing.T, row ...driver.Value) *sql.DB {
+	db, err := sql.Open("fake", "")
+	assert.NoError(t, err)
+
+	fake.mu.Lock()
+	fake.rows[t.Name()] = row
+	fake.mu.Unlock()
+
+	return db
+}
+
+func TestSQLRoundTrip(t *testing.T) {
+	db := openFake(t)
+	defer db.Close()
+
+	created, _ := datetime.New(2026, 10, 18, 14, 5, 9)
+	birthday, _ := date.New(1990, 2, 28)
+	opens, _ := time.New(8, 30, 0)
+	window, _ := datetime.NewRangeStartStrict("2026-01-01 00:00:00", "")
+	days, _ := date.NewRangeStartStrict("2026-12-24", "2026-12-27")
+	hours, _ := time.NewRangeStartStrict("09:00:00", "17:30:00")
+
+	_, err := db.Exec(t.Name(), *created, birthday.(*date.Date), opens.(*time.Time), datetime.NullDateTime{}, *window, *days, *hours)
+	assert.NoError(t, err)
+
+	fake.mu.Lock()
+	assert.Equal(t, []driver.Value{
+		created.Time(),
+		"1990-02-28",
+		"08:30:00",
+		nil,
+		`["2026-01-01 00:00:00",)`,
+		"[2026-12-24,2026-12-27)",
+		"[09:00:00,17:30:00)",
+	}, fake.rows[t.Name()])
+	fake.mu.Unlock()
+
+	var gotCreated datetime.DateTime
+	var gotBirthday date.Date
+	var gotOpens time.Time
+	var gotUpdated datetime.NullDateTime
+	var gotWindow datetime.Range
+	var gotDays date.Range
+	var gotHours time.Range
+
+	err = db.QueryRow(t.Name()).Scan(&gotCreated, &gotBirthday, &gotOpens, &gotUpdated, &gotWindow, &gotDays, &gotHours)
+	assert.NoError(t, err)
+
+	assert.Equal(t, *created, gotCreated)
+	assert.Equal(t, *birthday.(*date.Date), gotBirthday)
+	assert.Equal(t, *opens.(*time.Time), gotOpens)
+	assert.False(t, gotUpdated.Valid)
+	assert.Equal(t, *window, gotWindow)
+	assert.Equal(t, *days, gotDays)
+	assert.Equal(t, *hours, gotHours)
+}
+
+func TestSQLScanDriverValues(t *testing.T) {
+	expected, _ := datetime.New(2026, 10, 18, 14, 5, 9)
+	expectedDate, _ := date.New(2026, 10, 18)
+	expectedTime, _ := time.New(14, 5, 9)
+	prague, _ := goTime.LoadLocation("Europe/Prague")
+
+	tests := []struct {
+		name  string
+		value driver.Value
+	}{
+		{"time.Time", goTime.Date(2026, 10, 18, 14, 5, 9, 0, goTime.UTC)},
+		{"time.Time in location", goTime.Date(2026, 10, 18, 16, 5, 9, 0, prague)},
+		{"bytes", []byte("2026-10-18 14:05:09")},
+		{"string", "2026-10-18 14:05:09"},
+		{"RFC 3339", "2026-10-18T14:05:09Z"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db := openFake(t, tt.value)
+			defer db.Close()
+
+			var d datetime.DateTime
+			var n datetime.NullDateTime
+
+			assert.NoError(t, db.QueryRow(t.Name()).Scan(&d))
+			assert.Equal(t, *expected, d)
+			assert.NoError(t, db.QueryRow(t.Name()).Scan(&n))
+			assert.True(t, n.Valid)
+			assert.Equal(t, *expected, n.DateTime)
+		})
+	}
+
+	t.Run("date and time", func(t *testing.T) {
+		var d date.Date
+		var n date.NullDate
+		var tm time.Time
+		var nt time.NullTime
+
+		assert.NoError(t, d.Scan([]byte("2026-10-18")))
+		assert.Equal(t, *expectedDate.(*date.Date), d)
+		assert.NoError(t, d.Scan(goTime.Date(2026, 10, 18, 0, 0, 0, 0, goTime.UTC)))
+		assert.Equal(t, *expectedDate.(*date.Date), d)
+		assert.NoError(t, n.Scan("2026-10-18"))
+		assert.True(t, n.Valid)
+		assert.NoError(t, n.Scan(nil))
+		assert.False(t, n.Valid)
+
+		assert.NoError(t, tm.Scan("14:05:09"))
+		assert.Equal(t, *expectedTime.(*time.Time), tm)
+		assert.NoError(t, tm.Scan(goTime.Date(0, 1, 1, 14, 5, 9, 0, goTime.UTC)))
+		assert.Equal(t, *expectedTime.(*time.Time), tm)
+		assert.NoError(t, nt.Scan([]byte("14:05:09")))
+		assert.True(t, nt.Valid)
+		value, _ := time.NullTime{}.Value()
+		assert.Nil(t, value)
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		var d datetime.DateTime
+		var dd date.Date
+		var tm time.Time
+
+		assert.Error(t, d.Scan(nil))
+		assert.Error(t, d.Scan(int64(5)))
+		assert.Error(t, d.Scan("yesterday"))
+		assert.Error(t, dd.Scan(nil))
+		assert.Error(t, dd.Scan(3.14))
+		assert.Error(t, tm.Scan(nil))
+		assert.Error(t, tm.Scan(true))
+	})
+}
+
+func TestSQLRangeLiteral(t *testing.T) {
+	tests := []struct {
+		name     string
+		literal  string
+		expected string
+		err      bool
+	}{
+		{"quoted", `["2026-01-01 00:00:00","2026-02-01 00:00:00")`, "[2026-01-01 00:00:00, 2026-02-01 00:00:00)", false},
+		{"unbounded upper", `["2026-01-01 00:00:00",)`, "[2026-01-01 00:00:00, )", false},
+		{"unbounded lower", `(,"2026-02-01 00:00:00"]`, "(, 2026-02-01 00:00:00]", false},
+		{"infinity", `["2026-01-01 00:00:00",infinity)`, "[2026-01-01 00:00:00, )", false},
+		{"-infinity", `(-infinity,"2026-02-01 00:00:00")`, "(, 2026-02-01 00:00:00)", false},
+		{"quoted infinity", `("-infinity","2026-02-01 00:00:00")`, "(, 2026-02-01 00:00:00)", false},
+		{"spaces", ` [ "2026-01-01 00:00:00" , "2026-02-01 00:00:00" ) `, "[2026-01-01 00:00:00, 2026-02-01 00:00:00)", false},
+		{"empty", "empty", "", true},
+		{"missing comma", `["2026-01-01 00:00:00")`, "", true},
+		{"unterminated", `["2026-01-01 00:00:00,)`, "", true},
+		{"invalid bound", `[2026-13-01,)`, "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var r datetime.Range
+			err := r.Scan([]byte(tt.literal))
+
+			if tt.err {
+				assert.Error(t, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, r.String())
+		})
+	}
+
+	t.Run("daterange", func(t *testing.T) {
+		var r date.Range
+
+		assert.NoError(t, r.Scan("[2026-12-24,infinity)"))
+		assert.Equal(t, "[2026-12-24, )", r.String())
+		assert.Error(t, r.Scan("(,)"))
+		assert.Error(t, r.Scan(nil))
+
+		value, err := r.Value()
+		assert.NoError(t, err)
+		assert.Equal(t, "[2026-12-24,)", value)
+	})
+
+	t.Run("format", func(t *testing.T) {
+		assert.Equal(t, `["a\"b",)`, datetime.FormatRangeLiteral(datetime.RangeStartStrict, `a"b`, "", datetime.RangeEndOptional))
+		assert.Equal(t, `(,x]`, datetime.FormatRangeLiteral(datetime.RangeStartOptional, "", "x", datetime.RangeEndStrict))
+	})
+}
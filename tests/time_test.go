@@ -17,7 +17,7 @@ func TestNewTime(t *testing.T) {
 		expected *time.Time
 		err      bool
 	}{
-		{20, 12, 25, &time.Time{Hour: 20, Minute: 12, Second: 25, DateTime: goTime.Date(0, 1, 1, 20, 12, 25, 0, goTime.UTC)}, false},
+		{20, 12, 25, newTestTime(20, 12, 25), false},
 		{24, 2, 30, nil, true},   // Invalid day for February
 		{20, 60, 10, nil, true},  // Invalid month (13)
 		{20, 4, 60, nil, true},   // April has only 30 days
@@ -43,12 +43,12 @@ func TestTimeCompare(t *testing.T) {
 		date2    *time.Time
 		expected int
 	}{
-		{&time.Time{Hour: 2024, Minute: 12, Second: 25, DateTime: goTime.Date(2024, 12, 25, 0, 0, 0, 0, goTime.UTC)},
-			&time.Time{Hour: 2024, Minute: 12, Second: 25, DateTime: goTime.Date(2024, 12, 25, 0, 0, 0, 0, goTime.UTC)}, 0},
-		{&time.Time{Hour: 2024, Minute: 12, Second: 25, DateTime: goTime.Date(2024, 12, 25, 0, 0, 0, 0, goTime.UTC)},
-			&time.Time{Hour: 2024, Minute: 12, Second: 26, DateTime: goTime.Date(2024, 12, 26, 0, 0, 0, 0, goTime.UTC)}, -1}, // 25th < 26th
-		{&time.Time{Hour: 2024, Minute: 12, Second: 26, DateTime: goTime.Date(2024, 12, 26, 0, 0, 0, 0, goTime.UTC)},
-			&time.Time{Hour: 2024, Minute: 12, Second: 25, DateTime: goTime.Date(2024, 12, 25, 0, 0, 0, 0, goTime.UTC)}, 1}, // 26th > 25th
+		{newTestTime(20, 12, 25),
+			newTestTime(20, 12, 25), 0},
+		{newTestTime(20, 12, 25),
+			newTestTime(20, 12, 26), -1}, // 25s < 26s
+		{newTestTime(20, 12, 26),
+			newTestTime(20, 12, 25), 1}, // 26s > 25s
 	}
 
 	for _, tt := range tests {
@@ -66,26 +66,26 @@ func TestTimeEqual(t *testing.T) {
 	}{
 		// Test 1: Equal DateTime instances
 		{
-			date1:    &time.Time{Hour: 20, Minute: 3, Second: 31},
-			date2:    &time.Time{Hour: 20, Minute: 3, Second: 31},
+			date1:    newTestTime(20, 3, 31),
+			date2:    newTestTime(20, 3, 31),
 			expected: true,
 		},
-		// Test 2: Different DateTime instances (different day)
+		// Test 2: Different Time instances (different second)
 		{
-			date1:    &time.Time{Hour: 20, Minute: 3, Second: 31},
-			date2:    &time.Time{Hour: 2024, Minute: 3, Second: 30},
+			date1:    newTestTime(20, 3, 31),
+			date2:    newTestTime(20, 3, 30),
 			expected: false,
 		},
-		// Test 3: Different DateTime instances (different month)
+		// Test 3: Different Time instances (different minute)
 		{
-			date1:    &time.Time{Hour: 20, Minute: 3, Second: 31},
-			date2:    &time.Time{Hour: 2024, Minute: 4, Second: 1},
+			date1:    newTestTime(20, 3, 31),
+			date2:    newTestTime(20, 4, 31),
 			expected: false,
 		},
-		// Test 4: Different DateTime instances (different year)
+		// Test 4: Different Time instances (different hour)
 		{
-			date1:    &time.Time{Hour: 20, Minute: 3, Second: 31},
-			date2:    &time.Time{Hour: 2025, Minute: 3, Second: 31},
+			date1:    newTestTime(20, 3, 31),
+			date2:    newTestTime(21, 3, 31),
 			expected: false,
 		},
 	}
@@ -376,3 +376,27 @@ func TestTimeRange(t *testing.T) {
 		}
 	})
 }
+
+func TestTimeAccessors(t *testing.T) {
+	tm := newTestTime(23, 59, 58)
+
+	assert.Equal(t, []int{23, 59, 58}, []int{tm.Hour(), tm.Minute(), tm.Second()})
+	assert.False(t, tm.IsZero())
+
+	var zero time.Time
+	midnight := newTestTime(0, 0, 0)
+	assert.True(t, zero.IsZero())
+	assert.False(t, midnight.IsZero())
+	assert.Equal(t, "00:00:00", zero.ToString())
+	assert.True(t, zero.Equal(midnight))
+}
+
+func newTestTime(hour, minute, second int) *time.Time {
+	t, err := time.New(hour, minute, second)
+
+	if err != nil {
+		panic(err)
+	}
+
+	return t.(*time.Time)
+}
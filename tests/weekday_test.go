@@ -0,0 +1,175 @@
+package tests
+
+import (
+	"github.com/gouef/datetime"
+	"github.com/gouef/datetime/date"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+func TestNthWeekdayOfMonth(t *testing.T) {
+	tests := []struct {
+		name     string
+		year     int
+		month    int
+		weekday  time.Weekday
+		n        int
+		expected string
+	}{
+		{"thanksgiving", 2026, 11, time.Thursday, 4, "2026-11-26"},
+		{"mother's day", 2026, 5, time.Sunday, 2, "2026-05-10"},
+		{"memorial day", 2026, 5, time.Monday, -1, "2026-05-25"},
+		{"first day of month", 2026, 11, time.Sunday, 1, "2026-11-01"},
+		{"fifth", 2026, 11, time.Monday, 5, "2026-11-30"},
+		{"last in february", 2026, 2, time.Friday, -1, "2026-02-27"},
+		{"fifth from the end", 2026, 11, time.Sunday, -5, "2026-11-01"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d, err := date.NthWeekdayOfMonth(tt.year, tt.month, tt.weekday, tt.n)
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, d.ToString())
+
+			rule, err := datetime.NthWeekdayOfMonth(tt.year, tt.month, tt.weekday, tt.n)
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, rule.Format(time.DateOnly))
+		})
+	}
+}
+
+func TestNthWeekdayOfMonthErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		month   int
+		weekday time.Weekday
+		n       int
+	}{
+		{"zero", 11, time.Thursday, 0},
+		{"no fifth", 11, time.Thursday, 5},
+		{"no sixth from the end", 11, time.Sunday, -6},
+		{"month", 13, time.Sunday, 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := date.NthWeekdayOfMonth(2026, tt.month, tt.weekday, tt.n)
+
+			assert.ErrorIs(t, err, datetime.ErrOutOfRange)
+		})
+	}
+}
+
+func TestNextPreviousWeekday(t *testing.T) {
+	monday := newTestDate(2026, 10, 19)
+
+	tests := []struct {
+		name     string
+		weekday  time.Weekday
+		next     string
+		previous string
+	}{
+		{"same weekday", time.Monday, "2026-10-26", "2026-10-12"},
+		{"friday", time.Friday, "2026-10-23", "2026-10-16"},
+		{"sunday", time.Sunday, "2026-10-25", "2026-10-18"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.next, monday.NextWeekday(tt.weekday).ToString())
+			assert.Equal(t, tt.previous, monday.PreviousWeekday(tt.weekday).ToString())
+		})
+	}
+}
+
+func TestLastBusinessDayOfMonth(t *testing.T) {
+	holidays := datetime.NewHolidayCalendar(datetime.GetDate(2026, 9, 30))
+
+	tests := []struct {
+		name     string
+		month    int
+		calendar datetime.BusinessCalendar
+		expected string
+	}{
+		{"saturday", 10, nil, "2026-10-30"},
+		{"sunday", 5, nil, "2026-05-29"},
+		{"business day", 9, nil, "2026-09-30"},
+		{"holiday", 9, holidays, "2026-09-29"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d, err := date.LastBusinessDayOfMonth(2026, tt.month, tt.calendar)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, d.ToString())
+		})
+	}
+}
+
+func TestRangeCountWeekdays(t *testing.T) {
+	tests := []struct {
+		name     string
+		from     string
+		to       string
+		weekdays []time.Weekday
+		expected int
+	}{
+		{"weekend", "2026-10-01", "2026-10-31", []time.Weekday{time.Saturday, time.Sunday}, 9},
+		{"workdays", "2026-10-01", "2026-10-31", []time.Weekday{time.Monday, time.Tuesday, time.Wednesday, time.Thursday, time.Friday}, 22},
+		{"thursdays", "2026-10-01", "2026-10-31", []time.Weekday{time.Thursday}, 5},
+		{"duplicate", "2026-10-01", "2026-10-31", []time.Weekday{time.Monday, time.Monday}, 4},
+		{"single day", "2026-10-19", "2026-10-19", []time.Weekday{time.Monday}, 1},
+		{"reversed", "2026-10-31", "2026-10-01", []time.Weekday{time.Monday}, 0},
+		{"no weekdays", "2026-10-01", "2026-10-31", nil, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := date.NewRangeStrict(tt.from, tt.to)
+			assert.NoError(t, err)
+
+			count, err := r.CountWeekdays(tt.weekdays...)
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, count)
+		})
+	}
+}
+
+func TestRangeCountWeekdaysUnbounded(t *testing.T) {
+	r, err := date.NewRangeStrict("2026-10-01", "")
+	assert.NoError(t, err)
+
+	_, err = r.CountWeekdays(time.Monday)
+
+	assert.ErrorIs(t, err, date.ErrRangeWithoutTo)
+	assert.NotErrorIs(t, err, date.ErrUnboundedRange)
+
+	r, err = date.NewRangeStrict("", "2026-10-31")
+	assert.NoError(t, err)
+
+	_, err = r.CountWeekdays(time.Monday)
+
+	assert.ErrorIs(t, err, date.ErrUnboundedRange)
+}
+
+func TestRangeCountWeekdaysBrackets(t *testing.T) {
+	// Mondays October 5 and October 26 are counted for every brackets, Range.Is excludes them
+	for _, value := range []string{"[2026-10-05, 2026-10-26]", "(2026-10-05, 2026-10-26)", "[2026-10-05, 2026-10-26)"} {
+		t.Run(value, func(t *testing.T) {
+			r, err := date.RangeFromString(value)
+			require.NoError(t, err)
+
+			count, err := r.CountWeekdays(time.Monday)
+
+			assert.NoError(t, err)
+			assert.Equal(t, 4, count)
+			assert.False(t, r.Is(newTestDate(2026, 10, 5)))
+			assert.False(t, r.Is(newTestDate(2026, 10, 26)))
+		})
+	}
+}
@@ -0,0 +1,114 @@
+package tests
+
+import (
+	"encoding"
+	"github.com/gouef/datetime"
+	"github.com/gouef/datetime/date"
+	"github.com/gouef/datetime/time"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+	"testing"
+)
+
+type yamlConfig struct {
+	Maintenance date.Range         `yaml:"maintenance"`
+	Freeze      date.Range         `yaml:"freeze"`
+	Window      datetime.Range     `yaml:"window"`
+	CutOff      time.Time          `yaml:"cutOff"`
+	Hours       time.Range         `yaml:"hours"`
+	Launch      datetime.DateTime  `yaml:"launch"`
+	Release     date.Date          `yaml:"release"`
+	Deadline    datetime.Value     `yaml:"deadline"`
+	Updated     *datetime.DateTime `yaml:"updated"`
+}
+
+func TestUnmarshalYAML(t *testing.T) {
+	input := `
+maintenance: [2026-12-24, 2026-12-26]
+freeze: "[2026-12-20, 2027-01-02)"
+window: (2026-01-01 00:00:00, ]
+cutOff: 17:30:00
+hours: [09:00:00, ~]
+launch: 2026-10-18 14:05:09
+release: 2026-11-01
+deadline: "2026-12-31 23:59:59"
+updated: null
+`
+
+	var config yamlConfig
+
+	assert.NoError(t, yaml.Unmarshal([]byte(input), &config))
+
+	assert.Equal(t, "[2026-12-24, 2026-12-26]", config.Maintenance.String())
+	assert.Equal(t, "[2026-12-20, 2027-01-02)", config.Freeze.String())
+	assert.Equal(t, "(2026-01-01 00:00:00, ]", config.Window.String())
+	assert.Equal(t, "17:30:00", config.CutOff.ToString())
+	assert.Equal(t, "[09:00:00, ]", config.Hours.String())
+	assert.Equal(t, "2026-10-18 14:05:09", config.Launch.ToString())
+	assert.Equal(t, "2026-11-01", config.Release.ToString())
+	assert.Equal(t, datetime.Value("2026-12-31 23:59:59"), config.Deadline)
+	assert.Nil(t, config.Updated)
+
+	out, err := yaml.Marshal(config)
+	assert.NoError(t, err)
+
+	var again yamlConfig
+
+	assert.NoError(t, yaml.Unmarshal(out, &again))
+	assert.Equal(t, config, again)
+}
+
+func TestUnmarshalYAMLErrors(t *testing.T) {
+	tests := []struct {
+		input    string
+		message  string
+		expected error
+	}{
+		{"maintenance: [2026-12-24]", "line 1, column 14: can not decode sequence into *date.Range", datetime.ErrInvalidFormat},
+		{"freeze: \"[2026-13-20, 2027-01-02)\"", "line 1, column 9: can not decode \"[2026-13-20, 2027-01-02)\" into *date.Range", datetime.ErrInvalidFormat},
+		{"freeze: \"[2026-02-30, 2027-01-02)\"", "line 1, column 9: can not decode \"[2026-02-30, 2027-01-02)\" into *date.Range", datetime.ErrOutOfRange},
+		{"cutOff: 25:30:00", "line 1, column 9: can not decode \"25:30:00\" into *time.Time", datetime.ErrInvalidFormat},
+		{"release:\n  day: 1", "line 2, column 3: can not decode mapping into *date.Date", datetime.ErrInvalidFormat},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			var config yamlConfig
+
+			err := yaml.Unmarshal([]byte(tt.input), &config)
+
+			var yamlErr *datetime.YAMLError
+			require.ErrorAs(t, err, &yamlErr)
+			assert.Contains(t, err.Error(), tt.message)
+			assert.ErrorIs(t, err, tt.expected)
+		})
+	}
+
+	var config yamlConfig
+
+	err := yaml.Unmarshal([]byte("release: 2026-02-30"), &config)
+
+	var rangeErr *datetime.FieldRangeError
+	require.ErrorAs(t, err, &rangeErr)
+	assert.Equal(t, "day", rangeErr.Field)
+	assert.Equal(t, 30, rangeErr.Value)
+}
+
+func TestTextUnmarshalerConfig(t *testing.T) {
+	// config loaders (env, flags) decode through encoding.TextUnmarshaler
+	env := map[string]encoding.TextUnmarshaler{
+		"2026-12-24 08:00:00":      &datetime.DateTime{},
+		"2026-12-24":               &date.Date{},
+		"17:30:00":                 &time.Time{},
+		"[2026-12-24, 2026-12-26]": &date.Range{},
+		"[09:00:00, 17:30:00)":     &time.Range{},
+		"(2026-12-24 08:00:00, ]":  &datetime.Range{},
+	}
+
+	for value, target := range env {
+		t.Run(value, func(t *testing.T) {
+			assert.NoError(t, target.UnmarshalText([]byte(value)))
+		})
+	}
+}
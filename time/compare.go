@@ -0,0 +1,18 @@
+package time
+
+import (
+	"github.com/gouef/datetime"
+)
+
+func (t Time) HasDate() bool {
+	return false
+}
+
+func (t Time) HasTime() bool {
+	return true
+}
+
+// CompareAt compares t with u up to granularity g, u must be time of day, see datetime.CompareAt
+func (t Time) CompareAt(u datetime.Interface, g datetime.Granularity) (int, error) {
+	return datetime.CompareAt(t, u, g)
+}
@@ -0,0 +1,14 @@
+package time
+
+import (
+	"github.com/gouef/datetime"
+	goTime "time"
+)
+
+// FromDateTime returns time of day of d, it is a function instead of method DateTime.TimeOfDay,
+// because package datetime can not import this package
+func FromDateTime(d datetime.DateTime) Time {
+	t := d.Time()
+
+	return Time{dateTime: goTime.Date(0, goTime.Month(1), 1, t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), goTime.UTC)}
+}
@@ -0,0 +1,27 @@
+package time
+
+import (
+	"github.com/gouef/datetime"
+)
+
+// Format formats time by pattern
+func (t Time) Format(pattern datetime.Pattern) string {
+	return datetime.FormatTime(t.Time(), pattern)
+}
+
+// Parse parses value by pattern into Time, date fields of pattern are ignored
+func Parse(pattern datetime.Pattern, value string) (datetime.Interface, error) {
+	parsed, err := datetime.ParseTime(pattern, value)
+
+	if err != nil {
+		return nil, err
+	}
+
+	t, err := NewNano(parsed.Hour(), parsed.Minute(), parsed.Second(), parsed.Nanosecond())
+
+	if err != nil {
+		return nil, err
+	}
+
+	return t, nil
+}
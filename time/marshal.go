@@ -0,0 +1,147 @@
+package time
+
+import (
+	"encoding/binary"
+	"fmt"
+	"github.com/gouef/datetime"
+)
+
+func (t Time) MarshalText() ([]byte, error) {
+	return []byte(t.ToString()), nil
+}
+
+func (t *Time) UnmarshalText(text []byte) error {
+	parsed, err := FromString(string(text))
+
+	if err != nil {
+		return err
+	}
+
+	*t = *parsed.(*Time)
+
+	return nil
+}
+
+// MarshalTextPattern returns t formatted by pattern, see Formatted for fields marshaled by pattern
+func (t Time) MarshalTextPattern(pattern datetime.Pattern) ([]byte, error) {
+	return []byte(t.Format(pattern)), nil
+}
+
+// UnmarshalTextPattern parses text by pattern into t
+func (t *Time) UnmarshalTextPattern(pattern datetime.Pattern, text []byte) error {
+	parsed, err := Parse(pattern, string(text))
+
+	if err != nil {
+		return err
+	}
+
+	*t = *parsed.(*Time)
+
+	return nil
+}
+
+func (t Time) MarshalJSON() ([]byte, error) {
+	return datetime.MarshalJSONText(t)
+}
+
+func (t *Time) UnmarshalJSON(data []byte) error {
+	return datetime.UnmarshalJSONText(t, data)
+}
+
+func (t Time) MarshalBinary() ([]byte, error) {
+	if t.Nanosecond() == 0 {
+		return []byte{1, byte(t.Hour()), byte(t.Minute()), byte(t.Second())}, nil
+	}
+
+	// version 2 adds 4 bytes of nanoseconds
+	data := []byte{2, byte(t.Hour()), byte(t.Minute()), byte(t.Second()), 0, 0, 0, 0}
+	binary.BigEndian.PutUint32(data[4:], uint32(t.Nanosecond()))
+
+	return data, nil
+}
+
+func (t *Time) UnmarshalBinary(data []byte) error {
+	nanosecond := 0
+
+	switch {
+	case len(data) == 4 && data[0] == 1:
+	case len(data) == 8 && data[0] == 2:
+		nanosecond = int(binary.BigEndian.Uint32(data[4:]))
+	default:
+		return fmt.Errorf("%w of time", datetime.ErrInvalidBinary)
+	}
+
+	parsed, err := NewNano(int(data[1]), int(data[2]), int(data[3]), nanosecond)
+
+	if err != nil {
+		return err
+	}
+
+	*t = *parsed.(*Time)
+
+	return nil
+}
+
+func (d Value) MarshalText() ([]byte, error) {
+	return []byte(d), nil
+}
+
+func (d *Value) UnmarshalText(text []byte) error {
+	value, err := StringToValue(string(text))
+
+	if err != nil {
+		return err
+	}
+
+	*d = value
+
+	return nil
+}
+
+func (d Value) MarshalJSON() ([]byte, error) {
+	return datetime.MarshalJSONText(d)
+}
+
+func (d *Value) UnmarshalJSON(data []byte) error {
+	return datetime.UnmarshalJSONText(d, data)
+}
+
+func (d Value) MarshalBinary() ([]byte, error) {
+	return d.MarshalText()
+}
+
+func (d *Value) UnmarshalBinary(data []byte) error {
+	return d.UnmarshalText(data)
+}
+
+func (d Range) MarshalText() ([]byte, error) {
+	return []byte(d.String()), nil
+}
+
+func (d *Range) UnmarshalText(text []byte) error {
+	parsed, err := RangeFromString(string(text))
+
+	if err != nil {
+		return err
+	}
+
+	*d = *parsed
+
+	return nil
+}
+
+func (d Range) MarshalJSON() ([]byte, error) {
+	return datetime.MarshalJSONText(d)
+}
+
+func (d *Range) UnmarshalJSON(data []byte) error {
+	return datetime.UnmarshalJSONText(d, data)
+}
+
+func (d Range) MarshalBinary() ([]byte, error) {
+	return d.MarshalText()
+}
+
+func (d *Range) UnmarshalBinary(data []byte) error {
+	return d.UnmarshalText(data)
+}
@@ -0,0 +1,32 @@
+package time
+
+import (
+	"github.com/gouef/datetime"
+	goTime "time"
+)
+
+// FloorToStep returns t rounded down to a multiple of step counted from midnight, e.g. 10:14 is 10:00 for 15 minutes
+func (t Time) FloorToStep(step goTime.Duration) Time {
+	return Time{dateTime: datetime.FloorToStep(t.Time(), step)}
+}
+
+// CeilToStep returns t rounded up to a multiple of step counted from midnight,
+// time rounded past midnight wraps to "00:00:00"
+func (t Time) CeilToStep(step goTime.Duration) Time {
+	return wrap(datetime.CeilToStep(t.Time(), step))
+}
+
+// RoundToStep returns t rounded to the nearest multiple of step counted from midnight, halfway values are rounded up,
+// time rounded past midnight wraps to "00:00:00"
+func (t Time) RoundToStep(step goTime.Duration) Time {
+	return wrap(datetime.RoundToStep(t.Time(), step))
+}
+
+// wrap returns time of day of value which is on January 1 of year 0 or the next day
+func wrap(value goTime.Time) Time {
+	if value.Day() != 1 {
+		return Time{dateTime: goTime.Date(0, goTime.Month(1), 1, 0, 0, 0, 0, goTime.UTC)}
+	}
+
+	return Time{dateTime: value}
+}
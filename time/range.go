@@ -1,11 +1,8 @@
 package time
 
 import (
-	"errors"
 	"fmt"
 	"github.com/gouef/datetime"
-	"github.com/gouef/validator"
-	"github.com/gouef/validator/constraints"
 	"regexp"
 	"time"
 )
@@ -24,19 +21,19 @@ type Range struct {
 func NewRange(from, to string, start datetime.RangeStart, end datetime.RangeEnd) (*Range, error) {
 
 	if from == "" && to == "" {
-		return nil, errors.New("from and to (both) can not be empty")
+		return nil, datetime.ErrEmptyRange
 	}
 
 	validFrom, err := getTimeFromDateTime(from)
 
 	if err != nil && from != "" {
-		return nil, errors.New(fmt.Sprintf("from (%s) is not valid", from))
+		return nil, err
 	}
 
 	validTo, err := getTimeFromDateTime(to)
 
 	if err != nil && to != "" {
-		return nil, errors.New(fmt.Sprintf("to (%s) is not valid", to))
+		return nil, err
 	}
 
 	if validFrom == nil {
@@ -75,19 +72,19 @@ func NewRangeStartOptional(from, to string) (*Range, error) {
 	return NewRange(from, to, datetime.RangeStartOptional, datetime.RangeEndStrict)
 }
 
+var rangeRegexp = regexp.MustCompile(RangeRegexp)
+
 func RangeFromString(dateRange string) (*Range, error) {
-	errs := validator.Validate(dateRange, constraints.RegularExpression{Regexp: RangeRegexp})
+	match := rangeRegexp.FindStringSubmatch(dateRange)
 
-	if len(errs) != 0 {
-		return nil, errors.New(fmt.Sprintf("unsupported format of date range \"%s\"", dateRange))
+	if match == nil {
+		return nil, &datetime.ParseError{Input: dateRange, Expected: "time range \"[15:04:05, 15:04:05]\""}
 	}
 
-	re := regexp.MustCompile(RangeRegexp)
-	match := re.FindStringSubmatch(dateRange)
 	openBracket, date1, date2, closeBracket := match[1], match[7], match[17], match[22]
 
 	if date1 == "" && date2 == "" {
-		return nil, errors.New(fmt.Sprintf("time range from and to can not be both empty \"%s\"", dateRange))
+		return nil, datetime.ErrEmptyRange
 	}
 
 	return NewRange(date1, date2, datetime.RangeStart(openBracket), datetime.RangeEnd(closeBracket))
@@ -141,12 +138,20 @@ func getTimeFromDateTime(date string) (datetime.Interface, error) {
 func (d *Range) format(date any) (datetime.Interface, error) {
 	switch i := date.(type) {
 	case time.Time:
-		return New(i.Hour(), i.Minute(), i.Second())
+		return NewNano(i.Hour(), i.Minute(), i.Second(), i.Nanosecond())
 	case *Time:
 		return i, nil
+	case Time:
+		return &i, nil
+	case datetime.DateTime:
+		t := FromDateTime(i)
+		return &t, nil
+	case *datetime.DateTime:
+		t := FromDateTime(*i)
+		return &t, nil
 	case string:
 		return FromString(i)
 	default:
-		return nil, errors.New("unsupported format of date")
+		return nil, fmt.Errorf("%w %T of time", datetime.ErrUnsupportedType, date)
 	}
 }
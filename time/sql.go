@@ -0,0 +1,97 @@
+package time
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"github.com/gouef/datetime"
+	goTime "time"
+)
+
+// Scan implements sql.Scanner, accepts time.Time, []byte and string
+func (t *Time) Scan(value any) error {
+	var parsed datetime.Interface
+	var err error
+
+	switch v := value.(type) {
+	case goTime.Time:
+		parsed, err = NewNano(v.Hour(), v.Minute(), v.Second(), v.Nanosecond())
+	case []byte:
+		parsed, err = FromString(string(v))
+	case string:
+		parsed, err = FromString(v)
+	case nil:
+		return fmt.Errorf("%w into Time, use NullTime", datetime.ErrNull)
+	default:
+		return fmt.Errorf("%w %T scanned into Time", datetime.ErrUnsupportedType, value)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	*t = *parsed.(*Time)
+
+	return nil
+}
+
+// Value implements driver.Valuer
+func (t Time) Value() (driver.Value, error) {
+	return t.ToString(), nil
+}
+
+// NullTime Time which may be NULL
+type NullTime struct {
+	Time  Time
+	Valid bool
+}
+
+// Scan implements sql.Scanner
+func (n *NullTime) Scan(value any) error {
+	if value == nil {
+		n.Time, n.Valid = Time{}, false
+		return nil
+	}
+
+	n.Valid = true
+
+	return n.Time.Scan(value)
+}
+
+// Value implements driver.Valuer
+func (n NullTime) Value() (driver.Value, error) {
+	if !n.Valid {
+		return nil, nil
+	}
+
+	return n.Time.Value()
+}
+
+// Scan implements sql.Scanner, accepts PostgreSQL range literal of time values
+func (d *Range) Scan(value any) error {
+	literal, err := datetime.ScanRangeLiteral(value)
+
+	if err != nil {
+		return err
+	}
+
+	start, from, to, end, err := datetime.ParseRangeLiteral(literal)
+
+	if err != nil {
+		return err
+	}
+
+	parsed, err := NewRange(from, to, start, end)
+
+	if err != nil {
+		return err
+	}
+
+	*d = *parsed
+
+	return nil
+}
+
+// Value implements driver.Valuer, returns PostgreSQL range literal
+func (d Range) Value() (driver.Value, error) {
+	return datetime.FormatRangeLiteral(d.start, string(d.from), string(d.to), d.end), nil
+}
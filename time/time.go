@@ -1,115 +1,189 @@
 package time
 
 import (
-	"errors"
-	"fmt"
+	"context"
 	"github.com/gouef/datetime"
-	"github.com/gouef/validator"
-	"github.com/gouef/validator/constraints"
-	"regexp"
-	"strconv"
+	"github.com/gouef/datetime/internal/scan"
 	goTime "time"
 )
 
 const (
 	Regexp         = `^(\d{2}):(\d{2}):(\d{2})?$`
 	DateRegexp     = datetime.YearRegexp + `-` + datetime.MonthRegexp + `-` + datetime.DayRegexp
-	TimeRegexp     = `(` + datetime.HourRegexp + `):(` + datetime.MinuteRegexp + `):(` + datetime.SecondRegexp + `)`
+	TimeRegexp     = `(` + datetime.HourRegexp + `):(` + datetime.MinuteRegexp + `):(` + datetime.SecondRegexp + `)` + datetime.FractionRegexp
 	DateTimeRegexp = `((` + DateRegexp + `)?\s*(` + TimeRegexp + `))`
 	//DateTimeRegexp = `^((` + datetime.DateRegexp + `)?\s*((` + datetime.HourRegexp + `):(` + datetime.MinuteRegexp + `):(` + datetime.SecondRegexp + `)))$`
 )
 
+// Time time of day, it is immutable and safe to share between goroutines.
+// Create it by New, FromString or Now, zero value is midnight "00:00:00", see IsZero
 type Time struct {
-	Hour     int `validate:"min=0,max=23"`
-	Minute   int `validate:"min=0,max=59"`
-	Second   int `validate:"min=0,max=59"`
-	DateTime goTime.Time
+	dateTime goTime.Time
 }
 
+// Now current time of clock set by SetClock
 func Now() *Time {
-	now := goTime.Now()
+	return NowContext(context.Background())
+}
 
-	return &Time{
-		Hour:     now.Hour(),
-		Minute:   now.Minute(),
-		Second:   now.Second(),
-		DateTime: now,
-	}
+// NowContext current time of clock carried by ctx, see WithClock
+func NowContext(ctx context.Context) *Time {
+	now := datetime.ClockFromContext(ctx).Now()
+
+	return &Time{dateTime: goTime.Date(0, goTime.Month(1), 1, now.Hour(), now.Minute(), now.Second(), now.Nanosecond(), goTime.UTC)}
 }
 
 func New(hour, minute, second int) (datetime.Interface, error) {
-	errs := validator.Validate(hour, constraints.Range{Min: 0, Max: 23})
+	return NewNano(hour, minute, second, 0)
+}
 
-	if len(errs) > 0 {
-		return nil, errors.New(fmt.Sprintf("hour must be between 0-23 get \"%d\"", hour))
+// NewNano like New with fraction of second in nanoseconds 0-999999999
+func NewNano(hour, minute, second, nanosecond int) (datetime.Interface, error) {
+	t, err := newTime(hour, minute, second, nanosecond)
+
+	if err != nil {
+		return nil, err
 	}
 
-	errs = validator.Validate(minute, constraints.Range{Min: 0, Max: 59})
+	return &t, nil
+}
+
+// Validate checks ranges of fields. It is kept for struct validation by validators like Validate of DateTime
+// and Date, but it never fails, because every Time has valid fields
+func (t Time) Validate() error {
+	_, err := newTime(t.Hour(), t.Minute(), t.Second(), t.Nanosecond())
+	return err
+}
+
+// newTime validates fields and returns Time by value, so that ParseString does not allocate
+func newTime(hour, minute, second, nanosecond int) (Time, error) {
+	if err := datetime.CheckField("hour", hour, 0, 23); err != nil {
+		return Time{}, err
+	}
 
-	if len(errs) > 0 {
-		return nil, errors.New(fmt.Sprintf("minute must be between 0-59 get \"%d\"", minute))
+	if err := datetime.CheckField("minute", minute, 0, 59); err != nil {
+		return Time{}, err
 	}
 
-	errs = validator.Validate(second, constraints.Range{Min: 0, Max: 59})
+	if err := datetime.CheckField("second", second, 0, 59); err != nil {
+		return Time{}, err
+	}
 
-	if len(errs) > 0 {
-		return nil, errors.New(fmt.Sprintf("second must be between 0-59 get \"%d\"", second))
+	if err := datetime.CheckField("nanosecond", nanosecond, 0, 999999999); err != nil {
+		return Time{}, err
 	}
 
-	return &Time{
-		Hour:     hour,
-		Minute:   minute,
-		Second:   second,
-		DateTime: goTime.Date(0, goTime.Month(1), 1, hour, minute, second, 0, goTime.UTC),
-	}, nil
+	return Time{dateTime: goTime.Date(0, goTime.Month(1), 1, hour, minute, second, nanosecond, goTime.UTC)}, nil
 }
 
 func FromString(value string) (datetime.Interface, error) {
-	errs := validator.Validate(value, constraints.RegularExpression{Regexp: DateTimeRegexp})
+	t, err := ParseString(value)
 
-	if len(errs) != 0 {
-		return nil, errors.New(fmt.Sprintf("unsupported format of time \"%s\"", value))
+	if err != nil {
+		return nil, err
 	}
 
-	re := regexp.MustCompile(DateTimeRegexp)
-	match := re.FindStringSubmatch(value)
-	hour, _ := strconv.Atoi(match[8])
-	minute, _ := strconv.Atoi(match[9])
-	second, _ := strconv.Atoi(match[10])
+	return &t, nil
+}
+
+// ParseString parses the first time "15:04:05" with optional fraction of second ".999999999" in value,
+// e.g. in "2006-01-02 15:04:05", like FromString,
+// but returns Time by value and does not allocate unless value is invalid
+func ParseString(value string) (Time, error) {
+	for i := 0; i+8 <= len(value); i++ {
+		if value[i+2] != ':' || value[i+5] != ':' {
+			continue
+		}
+
+		hour, okHour := scan.Digits(value, i, 2)
+		minute, okMinute := scan.Digits(value, i+3, 2)
+		second, okSecond := scan.Digits(value, i+6, 2)
+
+		if okHour && okMinute && okSecond && hour <= 23 && minute <= 59 && second <= 59 {
+			// "." without digits is not part of time
+			nanosecond, _, _ := scan.Fraction(value, i+8)
+
+			return newTime(hour, minute, second, nanosecond)
+		}
+	}
 
-	return New(hour, minute, second)
+	return Time{}, &datetime.ParseError{Input: value, Expected: "time \"15:04:05\""}
 }
 
-func (t *Time) FromString(value string) (datetime.Interface, error) {
+func (t Time) FromString(value string) (datetime.Interface, error) {
 	return FromString(value)
 }
 
-func (t *Time) ToString() string {
-	return t.Time().Format(goTime.TimeOnly)
+// ToString returns "15:04:05", fraction of second is added without trailing zeros when it is not zero
+func (t Time) ToString() string {
+	return t.Time().Format(goTime.TimeOnly + ".999999999")
+}
+
+// ToStringPrecision returns "15:04:05" with fraction of second of precision digits
+func (t Time) ToStringPrecision(precision datetime.Precision) string {
+	return t.Time().Format(goTime.TimeOnly + precision.Layout())
+}
+
+func (t Time) Hour() int {
+	return t.dateTime.Hour()
+}
+
+func (t Time) Minute() int {
+	return t.dateTime.Minute()
+}
+
+func (t Time) Second() int {
+	return t.dateTime.Second()
+}
+
+func (t Time) Nanosecond() int {
+	return t.dateTime.Nanosecond()
+}
+
+// IsZero reports whether t is zero value, it is equal to midnight, but New(0, 0, 0) is not zero
+func (t Time) IsZero() bool {
+	return t.dateTime.IsZero()
+}
+
+// Time returns time of day on January 1 of year 0
+func (t Time) Time() goTime.Time {
+	if t.dateTime.IsZero() {
+		return goTime.Date(0, goTime.Month(1), 1, 0, 0, 0, 0, goTime.UTC)
+	}
+
+	return t.dateTime
+}
+
+// Truncate returns t rounded down to a multiple of unit counted from midnight, e.g. goTime.Millisecond,
+// so that units which do not divide day do not move t to the previous day
+func (t Time) Truncate(unit goTime.Duration) Time {
+	return t.FloorToStep(unit)
 }
 
-func (t *Time) Time() goTime.Time {
-	return goTime.Date(0, goTime.Month(1), 1, t.Hour, t.Minute, t.Second, 0, goTime.UTC)
+// Round returns t rounded to the nearest multiple of unit counted from midnight, halfway values are rounded up,
+// time rounded past midnight wraps to "00:00:00"
+func (t Time) Round(unit goTime.Duration) Time {
+	return t.RoundToStep(unit)
 }
 
 // Compare compares the date instant d with u. If d is before u, it returns -1;
 // if d is after u, it returns +1; if they're the same, it returns 0.
-func (t *Time) Compare(u datetime.Interface) int {
+func (t Time) Compare(u datetime.Interface) int {
 	return t.Time().Compare(u.Time())
 }
 
-func (t *Time) Equal(u datetime.Interface) bool {
+func (t Time) Equal(u datetime.Interface) bool {
 	return t.Time().Equal(u.Time())
 }
 
-func (t *Time) Between(start, end datetime.Interface) bool {
+func (t Time) Between(start, end datetime.Interface) bool {
 	return t.Before(end) && t.After(start)
 }
 
-func (t *Time) Before(u datetime.Interface) bool {
+func (t Time) Before(u datetime.Interface) bool {
 	return t.Time().Before(u.Time())
 }
 
-func (t *Time) After(u datetime.Interface) bool {
+func (t Time) After(u datetime.Interface) bool {
 	return t.Time().After(u.Time())
 }
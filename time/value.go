@@ -1,27 +1,25 @@
 package time
 
 import (
-	"errors"
-	"fmt"
 	"github.com/gouef/datetime"
-	"github.com/gouef/validator"
-	"github.com/gouef/validator/constraints"
+	goTime "time"
 )
 
 type Value string
 
 func StringToValue(value string) (Value, error) {
-	errs := validator.Validate(value, constraints.RegularExpression{Regexp: DateTimeRegexp})
+	t, err := ParseString(value)
 
-	time, err := FromString(value)
-
-	if len(errs) != 0 || err != nil {
-		return "", errors.New(fmt.Sprintf("unsupported format of time \"%s\"", value))
+	if err != nil {
+		return "", err
 	}
 
-	str := time.ToString()
+	// value is already in canonical format when it is the time only
+	if len(value) == len(goTime.TimeOnly) {
+		return Value(value), nil
+	}
 
-	return Value(str), nil
+	return Value(t.ToString()), nil
 }
 
 func (d Value) String() string {
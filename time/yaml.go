@@ -0,0 +1,30 @@
+package time
+
+import (
+	"github.com/gouef/datetime"
+	"gopkg.in/yaml.v3"
+)
+
+func (t Time) MarshalYAML() (any, error) {
+	return datetime.MarshalYAMLText(t)
+}
+
+func (t *Time) UnmarshalYAML(node *yaml.Node) error {
+	return datetime.UnmarshalYAMLText(t, node)
+}
+
+func (d Value) MarshalYAML() (any, error) {
+	return datetime.MarshalYAMLText(d)
+}
+
+func (d *Value) UnmarshalYAML(node *yaml.Node) error {
+	return datetime.UnmarshalYAMLText(d, node)
+}
+
+func (d Range) MarshalYAML() (any, error) {
+	return datetime.MarshalYAMLText(d)
+}
+
+func (d *Range) UnmarshalYAML(node *yaml.Node) error {
+	return datetime.UnmarshalYAMLRange(d, node)
+}
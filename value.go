@@ -1,26 +1,24 @@
 package datetime
 
 import (
-	"errors"
-	"fmt"
-	"github.com/gouef/validator"
-	"github.com/gouef/validator/constraints"
+	"time"
 )
 
 type Value string
 
 func StringToValue(value string) (Value, error) {
-	errs := validator.Validate(value, constraints.RegularExpression{Regexp: Regexp})
+	d, err := ParseString(value)
 
-	d, err := FromString(value)
-
-	if len(errs) != 0 || err != nil {
-		return "", errors.New(fmt.Sprintf("unsupported format of date time \"%s\"", value))
+	if err != nil {
+		return "", err
 	}
 
-	str := d.ToString()
+	// value is already in canonical format unless year is not 4 digits or separator is not space
+	if len(value) == len(time.DateTime) && value[10] == ' ' {
+		return Value(value), nil
+	}
 
-	return Value(str), nil
+	return Value(d.ToString()), nil
 }
 
 func (d Value) Date() Interface {
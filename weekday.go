@@ -0,0 +1,67 @@
+package datetime
+
+import (
+	"fmt"
+	"time"
+)
+
+// NthWeekdayOfMonth returns midnight of n-th weekday of month in UTC, negative n counts from the end of month,
+// e.g. the fourth Thursday of November or the last Monday of May for n = -1
+func NthWeekdayOfMonth(year, month int, weekday time.Weekday, n int) (time.Time, error) {
+	if err := CheckField("month", month, 1, 12); err != nil {
+		return time.Time{}, err
+	}
+
+	days := DaysInMonth(year, month)
+	first := GetDate(year, month, 1)
+	// the first and the last weekday in month
+	day := 1 + (int(weekday)-int(first.Weekday())+7)%7
+	last := day + (days-day)/7*7
+
+	switch {
+	case n > 0:
+		day += (n - 1) * 7
+	case n < 0:
+		day = last + (n+1)*7
+	}
+
+	if n == 0 || day < 1 || day > days {
+		return time.Time{}, fmt.Errorf("%w: there is no %d. %s in %d-%02d", ErrOutOfRange, n, weekday, year, month)
+	}
+
+	return GetDate(year, month, day), nil
+}
+
+// NextWeekday returns the first weekday after t, t itself is not returned
+func NextWeekday(t time.Time, weekday time.Weekday) time.Time {
+	days := (int(weekday) - int(t.Weekday()) + 7) % 7
+
+	if days == 0 {
+		days = 7
+	}
+
+	return t.AddDate(0, 0, days)
+}
+
+// PreviousWeekday returns the last weekday before t, t itself is not returned
+func PreviousWeekday(t time.Time, weekday time.Weekday) time.Time {
+	days := (int(t.Weekday()) - int(weekday) + 7) % 7
+
+	if days == 0 {
+		days = 7
+	}
+
+	return t.AddDate(0, 0, -days)
+}
+
+// LastBusinessDayOfMonth returns midnight of the last business day of month in UTC, it can be in previous month,
+// see AddBusinessDays for ErrNoBusinessDay
+func LastBusinessDayOfMonth(year, month int, calendar BusinessCalendar) (time.Time, error) {
+	t := GetDate(year, month, DaysInMonth(year, month))
+
+	if calendar.IsBusinessDay(t) {
+		return t, nil
+	}
+
+	return AddBusinessDays(t, -1, calendar)
+}
@@ -0,0 +1,125 @@
+package datetime
+
+import (
+	"encoding"
+	"fmt"
+	"gopkg.in/yaml.v3"
+)
+
+func (d DateTime) MarshalYAML() (any, error) {
+	return MarshalYAMLText(d)
+}
+
+func (d *DateTime) UnmarshalYAML(node *yaml.Node) error {
+	return UnmarshalYAMLText(d, node)
+}
+
+func (d Value) MarshalYAML() (any, error) {
+	return MarshalYAMLText(d)
+}
+
+func (d *Value) UnmarshalYAML(node *yaml.Node) error {
+	return UnmarshalYAMLText(d, node)
+}
+
+func (r Range) MarshalYAML() (any, error) {
+	return MarshalYAMLText(r)
+}
+
+func (r *Range) UnmarshalYAML(node *yaml.Node) error {
+	return UnmarshalYAMLRange(r, node)
+}
+
+// MarshalYAMLText marshals text of value as YAML string
+func MarshalYAMLText(value encoding.TextMarshaler) (any, error) {
+	text, err := value.MarshalText()
+
+	if err != nil {
+		return nil, err
+	}
+
+	return string(text), nil
+}
+
+// UnmarshalYAMLText unmarshals YAML scalar into value by its UnmarshalText, null is ignored.
+// Errors are returned as *YAMLError with line and column of node, yaml.Unmarshal returns the first of them
+func UnmarshalYAMLText(value encoding.TextUnmarshaler, node *yaml.Node) error {
+	if node.Kind != yaml.ScalarNode {
+		return yamlError(value, node, &ParseError{Input: yamlKind(node), Expected: "scalar"})
+	}
+
+	if node.Tag == "!!null" {
+		return nil
+	}
+
+	if err := value.UnmarshalText([]byte(node.Value)); err != nil {
+		return yamlError(value, node, err)
+	}
+
+	return nil
+}
+
+// UnmarshalYAMLRange unmarshals range from YAML scalar "[2026-12-24, 2026-12-26)" or from flow
+// sequence [2026-12-24, 2026-12-26], which is strict range, null item is unbounded
+func UnmarshalYAMLRange(value encoding.TextUnmarshaler, node *yaml.Node) error {
+	if node.Kind != yaml.SequenceNode {
+		return UnmarshalYAMLText(value, node)
+	}
+
+	if len(node.Content) != 2 || node.Content[0].Kind != yaml.ScalarNode || node.Content[1].Kind != yaml.ScalarNode {
+		return yamlError(value, node, &ParseError{Input: yamlKind(node), Expected: "sequence of two values"})
+	}
+
+	bounds := make([]string, 2)
+
+	for i, item := range node.Content {
+		if item.Tag != "!!null" {
+			bounds[i] = item.Value
+		}
+	}
+
+	text := fmt.Sprintf("%s%s, %s%s", RangeStartStrict, bounds[0], bounds[1], RangeEndStrict)
+
+	if err := value.UnmarshalText([]byte(text)); err != nil {
+		return yamlError(value, node, err)
+	}
+
+	return nil
+}
+
+// YAMLError node at Line and Column can not be decoded, it wraps cause, so that errors.Is and errors.As
+// work through yaml.Unmarshal
+type YAMLError struct {
+	Line   int
+	Column int
+	// Node kind of node or its value, e.g. "sequence" or "\"25:30:00\""
+	Node string
+	// Target type node was decoded into, e.g. "*date.Date"
+	Target string
+	Err    error
+}
+
+func (e *YAMLError) Error() string {
+	return fmt.Sprintf("line %d, column %d: can not decode %s into %s: %s", e.Line, e.Column, e.Node, e.Target, e.Err)
+}
+
+func (e *YAMLError) Unwrap() error {
+	return e.Err
+}
+
+func yamlError(value any, node *yaml.Node, err error) error {
+	return &YAMLError{Line: node.Line, Column: node.Column, Node: yamlKind(node), Target: fmt.Sprintf("%T", value), Err: err}
+}
+
+func yamlKind(node *yaml.Node) string {
+	switch node.Kind {
+	case yaml.ScalarNode:
+		return fmt.Sprintf("\"%s\"", node.Value)
+	case yaml.SequenceNode:
+		return "sequence"
+	case yaml.MappingNode:
+		return "mapping"
+	}
+
+	return "node"
+}